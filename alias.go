@@ -0,0 +1,82 @@
+package verify
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxAliasDepth bounds how deeply aliases may refer to other aliases, guarding against cycles.
+const maxAliasDepth = 10
+
+// RegisterAlias registers name as an alias for rules on the default Validator used by the package level functions.
+// See Validator.RegisterAlias for details.
+func RegisterAlias(name, rules string) {
+	defaultValidator.RegisterAlias(name, rules)
+}
+
+// RegisterAlias registers name as an alias for rules, which use the same syntax as a struct field tag. A tag like
+// `verify:"passphrase"` is then verified as if rules had been written in its place, so common bundles of rules can be
+// changed in one place. Aliases may refer to other aliases. Registering a name again replaces its rules.
+// An alias with the warn: prefix, or named by WithDryRun, reports the failures of each of its rules as warnings.
+// RegisterAlias panics if name is empty, contains a comma or equals sign, or is the name of a built-in sub-tag.
+func (vd *Validator) RegisterAlias(name, rules string) {
+	mustBeRegistrable("alias", name)
+
+	vd.mu.Lock()
+	defer vd.mu.Unlock()
+	if vd.aliases == nil {
+		vd.aliases = make(map[string]string)
+	}
+	vd.aliases[name] = rules
+	vd.clearCache()
+}
+
+// expandAliases returns the sub-tags of st with every alias replaced by the sub-tags it stands for.
+func (vd *Validator) expandAliases(st []string) ([]string, error) {
+	vd.mu.RLock()
+	defer vd.mu.RUnlock()
+	if len(vd.aliases) == 0 {
+		return st, nil
+	}
+	return vd.expand(st, 0)
+}
+
+func (vd *Validator) expand(st []string, depth int) ([]string, error) {
+	expanded := make([]string, 0, len(st))
+	for _, v := range st {
+		name := strings.TrimPrefix(v, warnPrefix)
+		rules, ok := vd.aliases[name]
+		if !ok {
+			expanded = append(expanded, v)
+			continue
+		}
+		if depth == maxAliasDepth {
+			return nil, fmt.Errorf("alias %q is nested too deeply, it may refer to itself", v)
+		}
+		sub, err := vd.expand(splitTag(rules), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if name != v || vd.dryRun[name] {
+			sub = warnRules(sub)
+		}
+		expanded = append(expanded, sub...)
+	}
+	return expanded, nil
+}
+
+// warnRules returns st with the warn: prefix added to each sub-tag that is a rule, rather than one such as omitempty
+// that changes how the other rules are verified.
+func warnRules(st []string) []string {
+	warned := make([]string, len(st))
+	for i, v := range st {
+		name, _, _ := strings.Cut(v, "=")
+		switch name {
+		case tagOmitEmpty, tagSecret, tagMsg, tagDive:
+			warned[i] = v
+		default:
+			warned[i] = warnPrefix + strings.TrimPrefix(v, warnPrefix)
+		}
+	}
+	return warned
+}
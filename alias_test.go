@@ -0,0 +1,63 @@
+package verify_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestRegisterAlias(t *testing.T) {
+	type A struct {
+		A string   `verify:"passphrase"`
+		B []string `verify:"names"`
+	}
+
+	v := verify.New()
+	v.RegisterAlias("passphrase", "required,minSize=12,maxSize=128")
+	v.RegisterAlias("name", "minSize=1,maxSize=3")
+	v.RegisterAlias("names", "maxSize=2,dive,name")
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"alias fails", A{A: "short"}, true},
+		{"nested alias fails", A{A: "long enough password", B: []string{"abcd"}}, true},
+		{"works", A{A: "long enough password", B: []string{"abc"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestRegisterAliasCycle(t *testing.T) {
+	type A struct {
+		A string `verify:"a"`
+	}
+
+	v := verify.New()
+	v.RegisterAlias("a", "b")
+	v.RegisterAlias("b", "a")
+	if err := v.Check(A{}); err == nil {
+		t.Error("expected an error for aliases that refer to each other")
+	}
+}
+
+func TestRegisterAliasPanics(t *testing.T) {
+	for _, name := range []string{"", "a,b", "a=b", "required", "dive"} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic registering %q", name)
+				}
+			}()
+			verify.New().RegisterAlias(name, "required")
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// AuditReport is a serializable record of verifying a value, listing every rule evaluated along with its param and
+// outcome, including the rules that passed. It is intended to be stored alongside the value as evidence of the checks
+// it was subject to, for example in the audit trail of a financial submission.
+type AuditReport struct {
+	// Type is the name of the struct type verified, qualified by its package path.
+	Type string `json:"type"`
+	// Time is when the value was verified.
+	Time time.Time `json:"time"`
+	// Valid is set if the value passed every rule that is enforced, so It would return nil.
+	Valid bool `json:"valid"`
+	// Fields holds the record of each field that has rules, as described by the Fields of a Result.
+	Fields []AuditField `json:"fields"`
+}
+
+// AuditField is the record of verifying a single field in an AuditReport.
+type AuditField struct {
+	Field   string      `json:"field"`
+	Pointer string      `json:"pointer,omitempty"`
+	Outcome Outcome     `json:"outcome"`
+	Rules   []AuditRule `json:"rules"`
+}
+
+// AuditRule is the record of verifying a single rule in an AuditReport. Code and Message are set if the rule failed or
+// warned.
+type AuditRule struct {
+	Tag     string  `json:"tag"`
+	Param   string  `json:"param,omitempty"`
+	Outcome Outcome `json:"outcome"`
+	Code    string  `json:"code,omitempty"`
+	Message string  `json:"message,omitempty"`
+}
+
+// Audit verifies v in the same way as the package level Audit, using the options the Validator was configured with.
+func (vd *Validator) Audit(v interface{}) (*AuditReport, error) {
+	res := vd.Evaluate(v)
+	var ve *ValidationError
+	if res.Err != nil && !errors.As(res.Err, &ve) {
+		return nil, res.Err
+	}
+
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	report := &AuditReport{
+		Type:   t.PkgPath() + "." + t.Name(),
+		Time:   time.Now(),
+		Valid:  res.Err == nil,
+		Fields: make([]AuditField, len(res.Fields)),
+	}
+	for i, fr := range res.Fields {
+		af := AuditField{Field: fr.Field, Pointer: fr.Pointer, Outcome: fr.Outcome, Rules: make([]AuditRule, len(fr.Rules))}
+		for j, rr := range fr.Rules {
+			ar := AuditRule{Tag: rr.Tag, Param: rr.Param, Outcome: rr.Outcome}
+			if rr.Error != nil {
+				ar.Code, ar.Message = rr.Error.Code, rr.Error.Error()
+			}
+			af.Rules[j] = ar
+		}
+		report.Fields[i] = af
+	}
+	return report, nil
+}
+
+// MarshalText encodes the Outcome as its name, such as "passed", so it is readable in serialized reports.
+func (o Outcome) MarshalText() ([]byte, error) {
+	if o < Skipped || o > Failed {
+		return nil, fmt.Errorf("verify: invalid outcome %d", int(o))
+	}
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText decodes an Outcome from its name, as encoded by MarshalText.
+func (o *Outcome) UnmarshalText(text []byte) error {
+	for c := Skipped; c <= Failed; c++ {
+		if c.String() == string(text) {
+			*o = c
+			return nil
+		}
+	}
+	return fmt.Errorf("verify: invalid outcome %q", text)
+}
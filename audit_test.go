@@ -0,0 +1,99 @@
+package verify_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codyoss/verify"
+)
+
+type auditPayment struct {
+	Amount   int    `verify:"required,max=1000"`
+	Currency string `verify:"len=3"`
+	Memo     string `verify:"omitempty,maxSize=10"`
+	Token    string `verify:"secret,minSize=8"`
+}
+
+func TestAudit(t *testing.T) {
+	before := time.Now()
+	report, err := verify.New(verify.WithJSONPointer()).Audit(&auditPayment{Amount: 5000, Currency: "USD", Token: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Type != "github.com/codyoss/verify_test.auditPayment" || report.Valid || report.Time.Before(before) {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	b, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Valid  bool `json:"valid"`
+		Fields []struct {
+			Field   string `json:"field"`
+			Pointer string `json:"pointer"`
+			Outcome string `json:"outcome"`
+			Rules   []map[string]string
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		field, outcome string
+		rules          []map[string]string
+	}{
+		{"Amount", "failed", []map[string]string{
+			{"tag": "required", "outcome": "passed"},
+			{"tag": "max", "param": "1000", "outcome": "failed", "code": "MAX",
+				"message": "Amount has value greater than max 1000"},
+		}},
+		{"Currency", "passed", []map[string]string{{"tag": "len", "param": "3", "outcome": "passed"}}},
+		{"Memo", "skipped", []map[string]string{{"tag": "maxSize", "param": "10", "outcome": "skipped"}}},
+		{"Token", "failed", []map[string]string{
+			{"tag": "minSize", "param": "8", "outcome": "failed", "code": "MIN_SIZE",
+				"message": "Token has a length less than 8"},
+		}},
+	}
+	if got.Valid || len(got.Fields) != len(want) {
+		t.Fatalf("unexpected report %s", b)
+	}
+	for i, w := range want {
+		f := got.Fields[i]
+		if f.Field != w.field || f.Pointer != "/"+w.field || f.Outcome != w.outcome || len(f.Rules) != len(w.rules) {
+			t.Errorf("field %d: want %s %s, got %+v", i, w.field, w.outcome, f)
+			continue
+		}
+		for j, wr := range w.rules {
+			if len(f.Rules[j]) != len(wr) {
+				t.Errorf("field %s rule %d: want %v, got %v", w.field, j, wr, f.Rules[j])
+			}
+			for k, v := range wr {
+				if f.Rules[j][k] != v {
+					t.Errorf("field %s rule %d: want %s %q, got %q", w.field, j, k, v, f.Rules[j][k])
+				}
+			}
+		}
+	}
+
+	var decoded verify.AuditReport
+	if err := json.Unmarshal(b, &decoded); err != nil || decoded.Fields[0].Outcome != verify.Failed {
+		t.Errorf("expected the report to decode, got %v and %+v", err, decoded)
+	}
+
+	valid, err := verify.Audit(auditPayment{Amount: 1, Currency: "USD", Token: "abcdefgh"})
+	if err != nil || !valid.Valid {
+		t.Errorf("expected a valid report, got %v and %+v", err, valid)
+	}
+
+	type Invalid struct {
+		A bool `verify:"min=1"`
+	}
+	var ce *verify.ConfigError
+	if _, err := verify.Audit(Invalid{}); !errors.As(err, &ce) {
+		t.Errorf("expected a *verify.ConfigError, got %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package verify
+
+import (
+	"container/list"
+	"sync"
+)
+
+// defaultPatternCacheSize is the number of compiled regex patterns, and of formats built with a value, kept by default.
+const defaultPatternCacheSize = 1000
+
+// SetPatternCacheSize bounds the number of compiled regex patterns, and of formats built with a value such as
+// mime=image/png, that are kept so they may be shared by every field, struct type, and Validator using them. Each is
+// bounded to n entries, evicting the least recently used, so programs that build rules dynamically do not grow without
+// limit. An n of zero or less removes the bound. The default is 1000. Evicting an entry does not affect the rules
+// already compiled from it; it is only compiled again if another field uses it.
+func SetPatternCacheSize(n int) {
+	regexCache.resize(n)
+	formatCache.resize(n)
+}
+
+// lruCache holds at most size values, evicting the least recently used value when another is added. It is safe for
+// concurrent use.
+type lruCache[K comparable, V any] struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[K]*list.Element
+}
+
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func newLRUCache[K comparable, V any](size int) *lruCache[K, V] {
+	return &lruCache[K, V]{size: size, order: list.New(), items: make(map[K]*list.Element)}
+}
+
+// get returns the value for key, marking it as the most recently used.
+func (c *lruCache[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*lruEntry[K, V]).value, true
+}
+
+// add stores value for key, unless a value is already stored for it, and returns the value stored.
+func (c *lruCache[K, V]) add(key K, value V) V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*lruEntry[K, V]).value
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+	c.evict()
+	return value
+}
+
+// resize sets the number of values the cache holds, evicting values if it holds more.
+func (c *lruCache[K, V]) resize(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.size = size
+	c.evict()
+}
+
+// clear removes every value from the cache.
+func (c *lruCache[K, V]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.items = make(map[K]*list.Element)
+}
+
+// len returns the number of values the cache holds.
+func (c *lruCache[K, V]) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *lruCache[K, V]) evict() {
+	for c.size > 0 && c.order.Len() > c.size {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.items, e.Value.(*lruEntry[K, V]).key)
+	}
+}
@@ -0,0 +1,57 @@
+package verify_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestSetPatternCacheSize(t *testing.T) {
+	builds := map[string]int{}
+	verify.RegisterFormatFunc("countedPrefix", func(param string) (func(string) bool, error) {
+		builds[param]++
+		return func(s string) bool { return strings.HasPrefix(s, param) }, nil
+	})
+	defer verify.SetPatternCacheSize(1000)
+
+	type A struct {
+		A string `verify:"countedPrefix=a"`
+	}
+	type B struct {
+		B string `verify:"countedPrefix=a"`
+	}
+	type C struct {
+		C string `verify:"countedPrefix=c,regex=^c+$"`
+	}
+	if err := verify.New().It(A{A: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify.New().It(B{B: "b"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if builds["a"] != 1 {
+		t.Errorf("expected the format to be built once for every type and Validator, got %d builds", builds["a"])
+	}
+
+	verify.SetPatternCacheSize(1)
+	if err := verify.New().It(C{C: "cc"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify.New().It(A{A: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if builds["a"] != 2 || builds["c"] != 1 {
+		t.Errorf("expected the least recently used format to be evicted, got %v", builds)
+	}
+	if err := verify.New().It(C{C: "cd"}); err == nil {
+		t.Error("expected an evicted regex to be compiled again")
+	}
+
+	verify.RegisterFormatFunc("countedPrefix", func(param string) (func(string) bool, error) {
+		return func(s string) bool { return s == param }, nil
+	})
+	if err := verify.New().It(A{A: "ab"}); err == nil {
+		t.Error("expected registering a format again to replace its cached checks")
+	}
+}
@@ -0,0 +1,26 @@
+// Package example holds types whose Validate methods are generated by verifygen, to check that they report the same
+// failures as verify.It.
+package example
+
+//go:generate go run github.com/codyoss/verify/cmd/verifygen -type=User,Order
+
+// User uses only rules verifygen supports.
+type User struct {
+	Name     string            `verify:"required,minSize=2,maxSize=10"`
+	Code     string            `verify:"omitempty,len=3"`
+	Age      int8              `verify:"min=18,lte=1000"`
+	Score    float64           `verify:"omitempty,gt=0.5,lt=10.0"`
+	Count    uint              `verify:"max=5"`
+	Admin    bool              `verify:"required"`
+	Nickname *string           `verify:"required"`
+	Tags     []string          `verify:"minSize=1,omitempty,maxSize=2"`
+	Labels   map[string]string `verify:"omitempty,required"`
+	note     string            `verify:"required"`
+	Ignored  string            `verify:"-"`
+}
+
+// Order uses dive, which verifygen does not support, so its Validate method calls verify.It.
+type Order struct {
+	ID    string   `verify:"required"`
+	Items []string `verify:"dive,required"`
+}
@@ -0,0 +1,47 @@
+package example
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestValidateMatchesIt(t *testing.T) {
+	nick := "nick"
+	valid := User{Name: "ab", Age: 18, Admin: true, Nickname: &nick, Tags: []string{"a"}, note: "a"}
+
+	tests := []struct {
+		name  string
+		input func(u *User)
+	}{
+		{"valid", func(u *User) {}},
+		{"zero", func(u *User) { *u = User{} }},
+		{"too long", func(u *User) {
+			u.Name, u.Code, u.Tags = "abcdefghijk", "ab", []string{"a", "b", "c"}
+		}},
+		{"out of range", func(u *User) { u.Age, u.Count, u.Score = -1, 6, 0.5 }},
+		{"above range", func(u *User) { u.Age, u.Score = 127, 10 }},
+		{"negative zero", func(u *User) { u.Score = math.Copysign(0, -1) }},
+		{"empty tags", func(u *User) { u.Tags, u.Labels = []string{}, map[string]string{} }},
+		{"ignored", func(u *User) { u.Ignored = "a" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := valid
+			tt.input(&u)
+			got, want := u.Validate(), verify.It(u)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("want %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestValidateFallsBack(t *testing.T) {
+	o := Order{Items: []string{""}}
+	if got, want := o.Validate(), verify.It(o); got == nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by verifygen -type=User,Order; DO NOT EDIT.
+
+package example
+
+import "github.com/codyoss/verify"
+
+// Validate verifies u based on its struct field tags, reporting the same failures as verify.It.
+func (u User) Validate() error {
+	var errs []*verify.FieldError
+	if u.Name == "" {
+		errs = append(errs, verify.NewFieldError("Name", "required", "", u.Name, "Name is required but is set to zero value", verify.ErrRequired))
+	} else {
+		if len(u.Name) < 2 {
+			errs = append(errs, verify.NewFieldError("Name", "minSize", "2", u.Name, "Name has a length less than 2", verify.ErrMinSize))
+		}
+		if len(u.Name) > 10 {
+			errs = append(errs, verify.NewFieldError("Name", "maxSize", "10", u.Name, "Name has a length greater than 10", verify.ErrMaxSize))
+		}
+	}
+	if u.Code != "" {
+		if len(u.Code) != 3 {
+			errs = append(errs, verify.NewFieldError("Code", "len", "3", u.Code, "Code does not have a length of 3", verify.ErrLen))
+		}
+	}
+	if int64(u.Age) < 18 {
+		errs = append(errs, verify.NewFieldError("Age", "min", "18", u.Age, "Age has value less than min 18", verify.ErrMin))
+	}
+	if int64(u.Age) > 1000 {
+		errs = append(errs, verify.NewFieldError("Age", "lte", "1000", u.Age, "Age has value greater than max 1000", verify.ErrMax))
+	}
+	if u.Score != 0 {
+		if float64(u.Score) <= 0.5 {
+			errs = append(errs, verify.NewFieldError("Score", "gt", "0.5", u.Score, "Score has value not greater than 0.500000", verify.ErrGt))
+		}
+		if float64(u.Score) >= 10 {
+			errs = append(errs, verify.NewFieldError("Score", "lt", "10.0", u.Score, "Score has value not less than 10.000000", verify.ErrLt))
+		}
+	}
+	if uint64(u.Count) > 5 {
+		errs = append(errs, verify.NewFieldError("Count", "max", "5", u.Count, "Count has value greater than max 5", verify.ErrMax))
+	}
+	if !u.Admin {
+		errs = append(errs, verify.NewFieldError("Admin", "required", "", u.Admin, "Admin is required but is set to zero value", verify.ErrRequired))
+	}
+	if u.Nickname == nil {
+		errs = append(errs, verify.NewFieldError("Nickname", "required", "", u.Nickname, "Nickname is required but is set to zero value", verify.ErrRequired))
+	}
+	if u.Tags != nil {
+		if len(u.Tags) < 1 {
+			errs = append(errs, verify.NewFieldError("Tags", "minSize", "1", u.Tags, "Tags has a length less than 1", verify.ErrMinSize))
+		}
+		if len(u.Tags) > 2 {
+			errs = append(errs, verify.NewFieldError("Tags", "maxSize", "2", u.Tags, "Tags has a length greater than 2", verify.ErrMaxSize))
+		}
+	}
+	if u.Labels == nil {
+		errs = append(errs, verify.NewFieldError("Labels", "required", "", u.Labels, "Labels is required but is set to zero value", verify.ErrRequired))
+	}
+	if u.note == "" {
+		errs = append(errs, verify.NewFieldError("note", "required", "", nil, "note is required but is set to zero value", verify.ErrRequired))
+	}
+	if errs != nil {
+		return &verify.ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// Validate verifies o with verify.It, as it uses rules verifygen does not support.
+func (o Order) Validate() error {
+	return verify.It(o)
+}
@@ -0,0 +1,501 @@
+// Command verifygen generates a Validate method for each of the named struct types, which verifies the type based on
+// its verify struct field tags using plain Go comparisons, for services that can not afford the cost of reflection. It
+// is intended to be run by go generate:
+//
+//	//go:generate verifygen -type=User,Order
+//
+// The generated methods report the same FieldErrors as verify.It with the default Validator. verifygen supports the
+// sub-tags required, omitempty, minSize, maxSize, len, min, max, gt, gte, lt, and lte on fields whose types are
+// strings, bools, numbers, or slices or maps of them, as well as required on pointers to them. The Validate method of a
+// type using anything else, such as dive, embedded structs, named field types, or a Verify method, calls verify.It
+// instead, so it remains correct while still relying on reflection. Registrations made at run time, such as
+// RegisterMessage, aliases, and struct validation functions, are not seen by the generated code, so types relying on
+// them should call verify.It.
+//
+// The output is written to <type>_verify.go in the package directory, named after the first type, unless -output is
+// set.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// generatedPrefix starts the header of each file written by verifygen, which is skipped when reading a package so the
+// methods it already generated are not mistaken for ones written by hand.
+const generatedPrefix = "// Code generated by verifygen"
+
+// tagKey is the struct field tag key verify.It reads rules from.
+const tagKey = "verify"
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("verifygen: ")
+	typeNames := flag.String("type", "", "comma-separated list of type names; must be set")
+	output := flag.String("output", "", "output file name; default srcdir/<type>_verify.go")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of verifygen:\n")
+		fmt.Fprintf(os.Stderr, "\tverifygen [flags] -type T [directory]\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if args := flag.Args(); len(args) > 0 {
+		dir = args[0]
+	}
+
+	types := strings.Split(*typeNames, ",")
+	pkgName, files, err := parsePackage(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkgName, files, types, strings.Join(os.Args[1:], " "))
+	if err != nil {
+		log.Fatal(err)
+	}
+	out := *output
+	if out == "" {
+		out = filepath.Join(dir, strings.ToLower(types[0])+"_verify.go")
+	}
+	if err := os.WriteFile(out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parsePackage parses the Go files of the package in dir that match the current build context, other than tests and
+// files generated by verifygen.
+func parsePackage(dir string) (string, []*ast.File, error) {
+	pkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range pkg.GoFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(f.Comments) > 0 && strings.HasPrefix(f.Comments[0].Text(), generatedPrefix[3:]) {
+			continue
+		}
+		files = append(files, f)
+	}
+	return pkg.Name, files, nil
+}
+
+// generate returns the formatted source of a file in package pkgName holding a Validate method for each of the struct
+// types named by types, which are declared in files. args are the arguments verifygen was run with.
+func generate(pkgName string, files []*ast.File, types []string, args string) ([]byte, error) {
+	g := &generator{}
+	for _, name := range types {
+		st, err := findStruct(files, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.generateType(name, st, hasMethod(files, name, "Verify")); err != nil {
+			return nil, err
+		}
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "%s %s; DO NOT EDIT.\n\npackage %s\n\nimport \"github.com/codyoss/verify\"\n", generatedPrefix,
+		args, pkgName)
+	src.Write(g.buf.Bytes())
+	return format.Source(src.Bytes())
+}
+
+// findStruct returns the struct type named name declared in files, which must not already have a Validate method.
+func findStruct(files []*ast.File, name string) (*ast.StructType, error) {
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if ts.Name.Name != name {
+					continue
+				}
+				if hasMethod(files, name, "Validate") {
+					return nil, fmt.Errorf("%s already has a Validate method", name)
+				}
+				if ts.TypeParams != nil {
+					return nil, fmt.Errorf("%s is a generic type", name)
+				}
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					return nil, fmt.Errorf("%s is not a struct type", name)
+				}
+				return st, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("type %s not found", name)
+}
+
+// hasMethod reports whether a method called method is declared in files on the type name or a pointer to it.
+func hasMethod(files []*ast.File, name, method string) bool {
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Name.Name != method || len(fd.Recv.List) == 0 {
+				continue
+			}
+			t := fd.Recv.List[0].Type
+			if star, ok := t.(*ast.StarExpr); ok {
+				t = star.X
+			}
+			if id, ok := t.(*ast.Ident); ok && id.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// kind is the category of a field's type that determines the Go used to verify it.
+type kind int
+
+const (
+	kindString kind = iota
+	kindBool
+	kindInt
+	kindUint
+	kindFloat
+	kindPointer
+	kindSlice
+	kindMap
+)
+
+// basicKinds maps the name of each predeclared type verifygen supports to its kind.
+var basicKinds = map[string]kind{
+	"string": kindString,
+	"bool":   kindBool,
+	"int":    kindInt, "int8": kindInt, "int16": kindInt, "int32": kindInt, "int64": kindInt, "rune": kindInt,
+	"uint": kindUint, "uint8": kindUint, "uint16": kindUint, "uint32": kindUint, "uint64": kindUint,
+	"uintptr": kindUint, "byte": kindUint,
+	"float32": kindFloat, "float64": kindFloat,
+}
+
+// fieldKind returns the kind of a field of type expr, or false if verifygen does not support it.
+func fieldKind(expr ast.Expr) (kind, bool) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		k, ok := basicKinds[t.Name]
+		return k, ok
+	case *ast.StarExpr:
+		_, ok := fieldKind(t.X)
+		return kindPointer, ok
+	case *ast.ArrayType:
+		_, ok := fieldKind(t.Elt)
+		return kindSlice, ok && t.Len == nil
+	case *ast.MapType:
+		_, kok := fieldKind(t.Key)
+		_, vok := fieldKind(t.Value)
+		return kindMap, kok && vok
+	}
+	return 0, false
+}
+
+// errUnsupported is returned by generateField when a field uses a type or sub-tag verifygen does not support, so its
+// struct must be verified with verify.It.
+var errUnsupported = errors.New("unsupported")
+
+// generator accumulates the methods written by verifygen.
+type generator struct {
+	buf bytes.Buffer
+}
+
+// generateType writes the Validate method of the struct type name. If it uses anything verifygen does not support, or
+// it has a Verify method, the method calls verify.It.
+func (g *generator) generateType(name string, st *ast.StructType, verifier bool) error {
+	recv := strings.ToLower(name[:1])
+	var body bytes.Buffer
+	supported := !verifier
+	for _, f := range st.Fields.List {
+		var tag string
+		if f.Tag != nil {
+			raw, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return err
+			}
+			var ok bool
+			if tag, ok = reflect.StructTag(raw).Lookup(tagKey); !ok || tag == "-" {
+				tag = ""
+			}
+		}
+		if len(f.Names) == 0 {
+			// the fields of embedded structs are verified as part of the outer struct
+			supported = false
+			continue
+		}
+		if tag == "" {
+			// a field of any other type may implement verify.Verifier, whose Verify method verify.It calls
+			if _, ok := fieldKind(f.Type); !ok && ast.IsExported(f.Names[0].Name) {
+				supported = false
+			}
+			continue
+		}
+		for _, n := range f.Names {
+			err := g.generateField(&body, recv+"."+n.Name, n.Name, ast.IsExported(n.Name), f.Type, tag)
+			if errors.Is(err, errUnsupported) {
+				supported = false
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("invalid tag on %s.%s: %v", name, n.Name, err)
+			}
+		}
+	}
+
+	if !supported {
+		fmt.Fprintf(&g.buf, "\n// Validate verifies %s with verify.It, as it uses rules verifygen does not support.\n", recv)
+		fmt.Fprintf(&g.buf, "func (%s %s) Validate() error {\n\treturn verify.It(%s)\n}\n", recv, name, recv)
+		return nil
+	}
+	fmt.Fprintf(&g.buf,
+		"\n// Validate verifies %s based on its struct field tags, reporting the same failures as verify.It.\n", recv)
+	fmt.Fprintf(&g.buf, "func (%s %s) Validate() error {\n\tvar errs []*verify.FieldError\n", recv, name)
+	g.buf.Write(body.Bytes())
+	g.buf.WriteString("\tif errs != nil {\n\t\treturn &verify.ValidationError{Errors: errs}\n\t}\n\treturn nil\n}\n")
+	return nil
+}
+
+// check is the Go that verifies a single sub-tag.
+type check struct {
+	tag, param string
+	// fail is the condition under which the field fails the sub-tag.
+	fail string
+	msg  string
+	// err is the name of the sentinel error in the verify package the failure wraps.
+	err string
+}
+
+// generateField writes the checks of the field name, accessed as expr, of type typ with the rules in tag. Unexported
+// fields are reported with a nil Value, as verify.It can not read them.
+func (g *generator) generateField(w *bytes.Buffer, expr, name string, exported bool, typ ast.Expr, tag string) error {
+	k, ok := fieldKind(typ)
+	if !ok {
+		return errUnsupported
+	}
+	// required is verified first, and the other checks only if the field is set, as verify.It does
+	var required *check
+	var checks []check
+	omitempty := false
+	for _, v := range splitTag(tag) {
+		s, param, hasParam := strings.Cut(v, "=")
+		param = unquote(param)
+		c := check{tag: s, param: param}
+		switch s {
+		case "":
+			continue
+		case "omitempty":
+			omitempty = true
+			continue
+		case "required":
+			c.fail, c.msg, c.err = zeroCond(expr, k), name+" is required but is set to zero value", "ErrRequired"
+			if required == nil {
+				required = &c
+			}
+			continue
+		case "minSize", "maxSize", "len":
+			if !hasParam {
+				return fmt.Errorf("%s must have a value", s)
+			}
+			n, err := strconv.Atoi(param)
+			if err != nil {
+				return fmt.Errorf("%s value %q must be a number", s, param)
+			}
+			if k == kindPointer {
+				// verify.It follows the pointer, which verifygen does not support
+				return errUnsupported
+			}
+			if k != kindString && k != kindSlice && k != kindMap {
+				return fmt.Errorf("%s can only be used on a type that has a length", s)
+			}
+			switch s {
+			case "minSize":
+				c.fail, c.msg, c.err = fmt.Sprintf("len(%s) < %d", expr, n), fmt.Sprintf(
+					"%s has a length less than %d", name, n), "ErrMinSize"
+			case "maxSize":
+				c.fail, c.msg, c.err = fmt.Sprintf("len(%s) > %d", expr, n), fmt.Sprintf(
+					"%s has a length greater than %d", name, n), "ErrMaxSize"
+			default:
+				c.fail, c.msg, c.err = fmt.Sprintf("len(%s) != %d", expr, n), fmt.Sprintf(
+					"%s does not have a length of %d", name, n), "ErrLen"
+			}
+		case "min", "gte", "max", "lte", "gt", "lt":
+			if !hasParam {
+				return fmt.Errorf("%s must have a value", s)
+			}
+			var err error
+			if c, err = boundCheck(c, expr, name, k); err != nil {
+				return err
+			}
+		default:
+			return errUnsupported
+		}
+		checks = append(checks, c)
+	}
+
+	value := "nil"
+	if exported {
+		value = expr
+	}
+	appendError := func(c check, indent string) {
+		fmt.Fprintf(w, "%serrs = append(errs, verify.NewFieldError(%q, %q, %q, %s, %q, verify.%s))\n", indent, name,
+			c.tag, c.param, value, c.msg, c.err)
+	}
+	writeChecks := func(checks []check, indent string) {
+		for _, c := range checks {
+			fmt.Fprintf(w, "%sif %s {\n", indent, c.fail)
+			appendError(c, indent+"\t")
+			fmt.Fprintf(w, "%s}\n", indent)
+		}
+	}
+	switch {
+	case required != nil:
+		// the field fails required if and only if it is not set
+		fmt.Fprintf(w, "\tif %s {\n", required.fail)
+		appendError(*required, "\t\t")
+		if len(checks) > 0 {
+			w.WriteString("\t} else {\n")
+			writeChecks(checks, "\t\t")
+		}
+		w.WriteString("\t}\n")
+	case omitempty && len(checks) > 0:
+		fmt.Fprintf(w, "\tif %s {\n", nonZeroCond(expr, k))
+		writeChecks(checks, "\t\t")
+		w.WriteString("\t}\n")
+	default:
+		writeChecks(checks, "\t")
+	}
+	return nil
+}
+
+// bounds maps each sub-tag that limits the value of a number to the operator a failing field compares to its value
+// with, the name of the sentinel error, and the description of the failure.
+var bounds = map[string]struct{ op, err, desc string }{
+	"min": {"<", "ErrMin", "less than min"},
+	"gte": {"<", "ErrMin", "less than min"},
+	"max": {">", "ErrMax", "greater than max"},
+	"lte": {">", "ErrMax", "greater than max"},
+	"gt":  {"<=", "ErrGt", "not greater than"},
+	"lt":  {">=", "ErrLt", "not less than"},
+}
+
+// boundCheck completes c, a sub-tag that limits the value of a number, for the field name of kind k accessed as expr.
+// The field is converted to a 64 bit type, as verify.It compares it, so the value may be outside the range of its type.
+func boundCheck(c check, expr, name string, k kind) (check, error) {
+	b := bounds[c.tag]
+	c.err = b.err
+	switch k {
+	case kindInt:
+		i, err := strconv.ParseInt(c.param, 10, 64)
+		if err != nil {
+			if _, ferr := strconv.ParseFloat(c.param, 64); ferr == nil {
+				return c, fmt.Errorf("%s type is int while %s is float", name, c.tag)
+			}
+			return c, fmt.Errorf("%s value %q must be a number", c.tag, c.param)
+		}
+		c.fail = fmt.Sprintf("int64(%s) %s %d", expr, b.op, i)
+		c.msg = fmt.Sprintf("%s has value %s %d", name, b.desc, i)
+	case kindUint:
+		u, err := strconv.ParseUint(c.param, 10, 64)
+		if err != nil {
+			return c, fmt.Errorf("%s type is uint while %s is not an unsigned int", name, c.tag)
+		}
+		c.fail = fmt.Sprintf("uint64(%s) %s %d", expr, b.op, u)
+		c.msg = fmt.Sprintf("%s has value %s %d", name, b.desc, u)
+	case kindFloat:
+		if _, err := strconv.ParseInt(c.param, 10, 64); err == nil {
+			return c, fmt.Errorf("%s type is float while %s is int", name, c.tag)
+		}
+		f, err := strconv.ParseFloat(c.param, 64)
+		if err != nil {
+			return c, fmt.Errorf("%s value %q must be a number", c.tag, c.param)
+		}
+		c.fail = fmt.Sprintf("float64(%s) %s %s", expr, b.op, strconv.FormatFloat(f, 'g', -1, 64))
+		c.msg = fmt.Sprintf("%s has value %s %f", name, b.desc, f)
+	case kindPointer:
+		// verify.It follows the pointer, which verifygen does not support
+		return c, errUnsupported
+	default:
+		return c, fmt.Errorf("%s can only be used on a number", c.tag)
+	}
+	return c, nil
+}
+
+// zeroCond returns the condition under which a field of kind k accessed as expr fails required.
+func zeroCond(expr string, k kind) string {
+	switch k {
+	case kindString:
+		return expr + ` == ""`
+	case kindBool:
+		return "!" + expr
+	case kindInt, kindUint, kindFloat:
+		return expr + " == 0"
+	}
+	return expr + " == nil"
+}
+
+// nonZeroCond returns the condition under which the sub-tags following omitempty are verified for a field of kind k
+// accessed as expr.
+func nonZeroCond(expr string, k kind) string {
+	switch k {
+	case kindString:
+		return expr + ` != ""`
+	case kindBool:
+		return expr
+	case kindInt, kindUint, kindFloat:
+		return expr + " != 0"
+	}
+	return expr + " != nil"
+}
+
+// splitTag splits a verify tag into its sub-tags in the same way as the verify package, so commas within single
+// quotes do not separate sub-tags.
+func splitTag(tag string) []string {
+	var st []string
+	quoted, start := false, 0
+	for i := 0; i < len(tag); i++ {
+		switch tag[i] {
+		case '\'':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				st = append(st, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(st, tag[start:])
+}
+
+// unquote removes the single quotes wrapping the value of a sub-tag, if any.
+func unquote(param string) string {
+	if len(param) >= 2 && param[0] == '\'' && param[len(param)-1] == '\'' {
+		return param[1 : len(param)-1]
+	}
+	return param
+}
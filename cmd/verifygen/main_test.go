@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateExample(t *testing.T) {
+	dir := filepath.Join("internal", "example")
+	pkgName, files, err := parsePackage(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(pkgName, files, []string{"User", "Order"}, "-type=User,Order")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join(dir, "user_verify.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("user_verify.go is out of date, run go generate:\n%s", got)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		want     string
+		wantErr  string
+		fallback bool
+	}{
+		{"required", "type T struct { A *int `verify:\"required\"` }", "if t.A == nil {", "", false},
+		{"other tag keys", "type T struct { A []int `json:\"a\" verify:\"maxSize=2\"` }", "if len(t.A) > 2 {", "",
+			false},
+		{"quoted value", "type T struct { A uint `verify:\"min='1'\"` }", "if uint64(t.A) < 1 {", "", false},
+		{"untagged field", "type T struct { A time.Time }", "", "", true},
+		{"unsupported sub-tag", "type T struct { A string `verify:\"email\"` }", "", "", true},
+		{"unsupported type", "type T struct { A [2]int `verify:\"required\"` }", "", "", true},
+		{"embedded struct", "type T struct { U }\ntype U struct{}", "", "", true},
+		{"bound on pointer", "type T struct { A *int `verify:\"min=1\"` }", "", "", true},
+		{"verify method", "type T struct{}\nfunc (T) Verify() error { return nil }", "", "", true},
+		{"missing value", "type T struct { A string `verify:\"minSize\"` }", "", "T.A: minSize must have a value", false},
+		{"float value on int", "type T struct { A int `verify:\"max=1.5\"` }", "", "A type is int while max is float",
+			false},
+		{"int value on float", "type T struct { A float32 `verify:\"gt=1\"` }", "",
+			"A type is float while gt is int", false},
+		{"length of number", "type T struct { A int `verify:\"len=1\"` }", "", "len can only be used on a type", false},
+		{"not a struct", "type T int", "", "T is not a struct type", false},
+		{"already has Validate", "type T struct{}\nfunc (t *T) Validate() error { return nil }", "",
+			"T already has a Validate method", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parser.ParseFile(token.NewFileSet(), "t.go", "package p\n"+tt.src, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := generate("p", []*ast.File{f}, []string{"T"}, "-type=T")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("want an error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fallback := strings.Contains(string(got), "return verify.It(t)"); fallback != tt.fallback {
+				t.Errorf("want fallback %v, got:\n%s", tt.fallback, got)
+			}
+			if !strings.Contains(string(got), tt.want) {
+				t.Errorf("want output containing %q, got:\n%s", tt.want, got)
+			}
+		})
+	}
+}
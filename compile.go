@@ -0,0 +1,776 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// Compiled verifies values of a single struct type using rules that were parsed ahead of time by Compile.
+type Compiled struct {
+	vd    *Validator
+	typ   reflect.Type
+	rules *structRules
+}
+
+// It verifies v in the same way as the package level It, without parsing any struct field tags. v must be of the type
+// the Compiled was created for, or a pointer to it.
+func (c *Compiled) It(v interface{}) error {
+	rv := reflect.ValueOf(v)
+
+	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errInvalidKind
+	}
+	if rv.Type() != c.typ {
+		return fmt.Errorf("v provided must be a %s, got %s", c.typ, rv.Type())
+	}
+	st := newState(context.Background())
+	defer putState(st)
+	return c.vd.verify(st, c.rules, rv).Err
+}
+
+// structRules are the compiled rules of a struct type.
+type structRules struct {
+	fields []fieldRules
+	// structFunc is the function registered with RegisterStructValidation for the type, if any.
+	structFunc func(*StructLevel)
+	// verifier is set if the type implements Verifier.
+	verifier bool
+	// unexported is set if the type holds unexported fields, which can only be read through their address.
+	unexported bool
+}
+
+// fieldRules are the compiled rules of a single struct field.
+type fieldRules struct {
+	index int
+	// name is the name the field is reported by, and pointer its pointerToken.
+	name    string
+	pointer string
+	valueRules
+	// offset is the offset of the field within the struct, and fast its fastCheck, set if the Validator was created
+	// with WithUnsafeFieldAccess and every rule of the field supports it.
+	offset uintptr
+	fast   fastCheck
+}
+
+// valueRules are the compiled rules of a field, or of the elements of a field when dive is used.
+type valueRules struct {
+	// conv converts the value before it is verified, set when its type is registered with RegisterTypeFunc.
+	conv  TypeFunc
+	rules []*rule
+	// omitempty is set if the rules from omitFrom onwards, and everything verified after them, are skipped when the
+	// value is the zero value for its type.
+	omitempty bool
+	omitFrom  int
+	// msg replaces the message of each FieldError reported by rules, set when the msg sub-tag is used.
+	msg string
+	// secret is set if the value must not be reported in FieldErrors, when the secret sub-tag is used.
+	secret bool
+	// elem holds the rules for each element of a slice or array, set when dive is used.
+	elem *valueRules
+	// strct holds the rules of an embedded struct or of struct elements, which may be reached through a pointer.
+	strct *structRules
+	// embedded is set if strct belongs to an embedded struct, whose fields are reported as fields of the outer struct.
+	embedded bool
+	// verifier is set if the type of the value implements Verifier.
+	verifier bool
+}
+
+// compiler compiles the rules of struct types for a Validator. Struct types are compiled at most once, which also
+// allows self-referential types to be compiled.
+type compiler struct {
+	vd      *Validator
+	structs map[reflect.Type]*structRules
+
+	// check makes the compiler collect every configuration error in errs rather than stopping at the first one, and
+	// treat unknown sub-tags as errors.
+	check bool
+	errs  []error
+}
+
+func (vd *Validator) newCompiler() *compiler {
+	return &compiler{vd: vd, structs: make(map[reflect.Type]*structRules)}
+}
+
+// Check inspects the struct field tags of v in the same way as the package level Check, using the options the
+// Validator was configured with.
+func (vd *Validator) Check(v interface{}) error {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return errInvalidKind
+	}
+	c := vd.newCompiler()
+	c.check = true
+	if _, err := c.compileStruct(t); err != nil {
+		return err
+	}
+	return errors.Join(c.errs...)
+}
+
+// structRules returns the compiled rules of the struct type t, compiling them only if they are not already cached by
+// the Validator.
+func (vd *Validator) structRules(t reflect.Type) (*structRules, error) {
+	if err := vd.checkPrecompiled(t); err != nil {
+		return nil, err
+	}
+	if sr, ok := vd.cache.Load(t); ok {
+		return sr.(*structRules), nil
+	}
+	// with CompileEager, a precompiled type is only missing from the cache if a registration has discarded its rules
+	return vd.compileStruct(t)
+}
+
+// compileStruct compiles the rules of the struct type t, and of every struct type reached from it, and caches them.
+func (vd *Validator) compileStruct(t reflect.Type) (*structRules, error) {
+	c := vd.newCompiler()
+	sr, err := c.compileStruct(t)
+	if err != nil {
+		return nil, err
+	}
+	// every struct type reached from t is complete now, so they can all be shared
+	for st, r := range c.structs {
+		vd.cache.LoadOrStore(st, r)
+	}
+	return sr, nil
+}
+
+// Compile parses the struct field tags of t in the same way as the package level Compile, using the options the
+// Validator was configured with.
+func (vd *Validator) Compile(t reflect.Type) (*Compiled, error) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errInvalidKind
+	}
+	sr, err := vd.precompile(t)
+	if err != nil {
+		return nil, err
+	}
+	return &Compiled{vd: vd, typ: t, rules: sr}, nil
+}
+
+func (c *compiler) compileStruct(t reflect.Type) (*structRules, error) {
+	if sr, ok := c.structs[t]; ok {
+		return sr, nil
+	}
+	if !c.check {
+		if sr, ok := c.vd.cache.Load(t); ok {
+			return sr.(*structRules), nil
+		}
+	}
+	sr := &structRules{structFunc: c.vd.lookupStructFunc(t), verifier: implementsVerifier(t), unexported: hasUnexported(t)}
+	c.structs[t] = sr
+
+	messages, err := structMessages(t)
+	if err != nil {
+		if !c.check {
+			return nil, err
+		}
+		c.errs = append(c.errs, err)
+	}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		fr := fieldRules{index: i, name: c.vd.fieldName(sf)}
+		fr.pointer = pointerToken(fr.name)
+		tag, ok := sf.Tag.Lookup(c.vd.tagName)
+		if tag == tagSkip {
+			continue
+		}
+		if ok {
+			vr, err := c.compileValue(t, sf.Name, sf.Type, splitTag(tag))
+			if err != nil {
+				ce := &ConfigError{Type: t, Field: sf.Name, Err: err}
+				if !c.check {
+					return nil, ce
+				}
+				c.errs = append(c.errs, ce)
+				continue
+			}
+			applyMessages(vr, sf.Name, messages)
+			fr.valueRules = *vr
+			if c.vd.unsafeAccess {
+				fr.offset, fr.fast = sf.Offset, fastField(vr, sf.Type)
+			}
+		}
+		// the exported fields of an unexported embedded struct are promoted as well, and reflect allows them to be read
+		if sf.Anonymous {
+			if et := derefType(sf.Type); c.recurse(et) {
+				esr, err := c.compileStruct(et)
+				if err != nil {
+					return nil, err
+				}
+				fr.strct, fr.embedded = esr, true
+			}
+		}
+		// an embedded Verify method is promoted, so it is called when the outer struct is verified instead
+		if sf.PkgPath == "" && !sf.Anonymous {
+			fr.verifier = implementsVerifier(sf.Type)
+		}
+		if fr.rules != nil || fr.elem != nil || fr.strct != nil || fr.verifier {
+			sr.fields = append(sr.fields, fr)
+		}
+	}
+	return sr, nil
+}
+
+// compileValue compiles each of the sub-tags st for the field name of type t, a field of the struct type parent. parent
+// is nil if the value is not a struct field.
+func (c *compiler) compileValue(parent reflect.Type, name string, t reflect.Type, st []string) (*valueRules, error) {
+	vr := &valueRules{}
+	if fn, ok := c.vd.lookupTypeFunc(t); ok {
+		ct, err := convertedType(name, t, fn)
+		if err != nil {
+			return nil, err
+		}
+		vr.conv, t = fn, ct
+	}
+	st, err := c.vd.expandAliases(st)
+	if err != nil {
+		return nil, err
+	}
+	for j, v := range st {
+		warn := strings.HasPrefix(v, warnPrefix)
+		v = strings.TrimPrefix(v, warnPrefix)
+		s := ruleSpec{vd: c.vd, parent: parent, field: name, typ: t, tag: v}
+		if i := strings.IndexByte(v, '='); i != -1 {
+			s.tag, s.param, s.hasParam = v[:i], unquote(v[i+1:]), true
+		}
+		if warn {
+			switch s.tag {
+			case tagOmitEmpty, tagSecret, tagMsg, tagDive:
+				return nil, fmt.Errorf("%s can not be used with %s", warnPrefix, s.tag)
+			}
+		}
+
+		if s.tag == tagOmitEmpty {
+			vr.omitempty = true
+			continue
+		}
+		if s.tag == tagSecret {
+			vr.secret = true
+			continue
+		}
+		if s.tag == tagMsg {
+			if s.param == "" {
+				return nil, errMissingValueMsg
+			}
+			vr.msg = s.param
+			continue
+		}
+		if s.tag == tagDive {
+			if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+				return nil, errValueTypeDive
+			}
+			// every sub-tag after dive applies to the elements rather than the collection
+			elem, err := c.compileElem(parent, name, t.Elem(), st[j+1:])
+			if err != nil {
+				return nil, err
+			}
+			vr.elem = elem
+			for e := elem; vr.secret && e != nil; e = e.elem {
+				e.secret = true
+			}
+			break
+		}
+
+		build, ok := c.vd.lookupRule(s.tag)
+		if !ok {
+			if c.check && s.tag != "" {
+				return nil, fmt.Errorf("unknown sub-tag %q", s.tag)
+			}
+			continue
+		}
+		r, err := build(s)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			r.template, _ = c.vd.lookupMessage(s.tag)
+			r.code = ruleCode(r)
+			r.warn = warn || c.vd.dryRun[s.tag]
+			vr.rules = append(vr.rules, r)
+		}
+	}
+
+	// required, and the sub-tags that verify the field in the same way, are verified first, so a missing value is not
+	// reported again by the field's other rules, and omitempty only skips the other rules wherever it is written
+	sort.SliceStable(vr.rules, func(i, j int) bool { return vr.rules[i].presence() && !vr.rules[j].presence() })
+	if vr.omitempty {
+		vr.omitFrom = 0
+		for vr.omitFrom < len(vr.rules) && vr.rules[vr.omitFrom].presence() {
+			vr.omitFrom++
+		}
+	}
+	return vr, nil
+}
+
+// compileElem compiles the rules for the elements of a slice or array. Elements that are structs, or pointers to
+// structs, are verified based on their own struct field tags as well.
+func (c *compiler) compileElem(parent reflect.Type, name string, t reflect.Type, st []string) (*valueRules, error) {
+	vr := &valueRules{}
+	if len(st) > 0 {
+		var err error
+		if vr, err = c.compileValue(parent, name, t, st); err != nil {
+			return nil, err
+		}
+	}
+	if et := derefType(t); c.recurse(et) {
+		sr, err := c.compileStruct(et)
+		if err != nil {
+			return nil, err
+		}
+		vr.strct = sr
+	}
+	vr.verifier = implementsVerifier(t)
+	return vr, nil
+}
+
+// splitTag splits tag into its sub-tags at each comma, except for commas within a value wrapped in single quotes, such
+// as regex='^a{1,3}$'. The quotes are kept, so the sub-tags may be joined and split again.
+func splitTag(tag string) []string {
+	var st []string
+	quoted, start := false, 0
+	for i := 0; i < len(tag); i++ {
+		switch tag[i] {
+		case '\'':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				st = append(st, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(st, tag[start:])
+}
+
+// unquote removes the single quotes wrapping the value of a sub-tag, if any.
+func unquote(param string) string {
+	if len(param) >= 2 && param[0] == '\'' && param[len(param)-1] == '\'' {
+		return param[1 : len(param)-1]
+	}
+	return param
+}
+
+// recurse reports whether t is a struct type whose own fields should be verified. Types registered with
+// RegisterTypeFunc are verified as a whole instead.
+func (c *compiler) recurse(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	_, ok := c.vd.lookupTypeFunc(t)
+	return !ok
+}
+
+// hasUnexported reports whether the struct type t has unexported fields, including those of the structs and arrays it
+// holds by value other than time.Time, whose fields are never read individually.
+func hasUnexported(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			return true
+		}
+		ft := sf.Type
+		for ft.Kind() == reflect.Array {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft != timeType && hasUnexported(ft) {
+			return true
+		}
+	}
+	return false
+}
+
+// readable returns f, or if f was obtained through an unexported field, which reflect does not allow to be read with
+// Interface, the value at its address. A struct with unexported fields is verified through its address, or as an
+// addressable copy, so the fields are addressable.
+func readable(f reflect.Value) reflect.Value {
+	if f.CanInterface() || !f.CanAddr() {
+		return f
+	}
+	return reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+}
+
+// derefType returns the type t points to if it is a pointer type.
+func derefType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+// state is the state of a single call to verify a value.
+type state struct {
+	ctx  context.Context
+	done <-chan struct{}
+	// errs accumulates the FieldErrors found so far. Its array is reused by the next call once the state is returned to
+	// statePool, so the FieldErrors are copied out of it when verification is complete.
+	errs []*FieldError
+	// warnings holds the FieldErrors of rules with the warn: prefix, which are not counted as failures.
+	warnings []*FieldError
+	// record is set if the outcome of every rule is recorded in fields, for Evaluate.
+	record bool
+	fields []FieldResult
+	// frames holds the frames of the locations of the values being verified, from the root to the current value.
+	frames []frame
+	// visiting holds the structs being verified, from the root to the current value, to detect cycles.
+	visiting []visit
+	// worker is set if the state belongs to a worker of verifyElemsParallel, which does not split elements again.
+	worker bool
+	// depth is the number of levels the value being verified is nested below the root, limited by WithMaxDepth.
+	depth int
+	// elementCount is the number of elements counted for WithMaxElements, which elements points to instead if the
+	// state belongs to a worker of verifyElemsParallel.
+	elementCount int64
+	elements     *int64
+}
+
+// maxPooledErrs bounds the capacity of the errs of a state returned to statePool, so a single huge failure does not
+// keep its memory alive.
+const maxPooledErrs = 1024
+
+// statePool holds states, along with the arrays of their errs, for reuse by later calls, so bursts of failing values
+// do not each allocate their own.
+var statePool = sync.Pool{New: func() interface{} { return new(state) }}
+
+// newState returns a state from statePool for a call to verify a value with ctx. It should be returned with putState
+// once the call is complete.
+func newState(ctx context.Context) *state {
+	st := statePool.Get().(*state)
+	st.ctx, st.done = ctx, ctx.Done()
+	return st
+}
+
+// putState resets st and returns it to statePool.
+func putState(st *state) {
+	if cap(st.errs) > maxPooledErrs {
+		st.errs = nil
+	}
+	// clear the FieldErrors so the pool does not keep them alive
+	for i := range st.errs {
+		st.errs[i] = nil
+	}
+	*st = state{errs: st.errs[:0], frames: st.frames[:0], visiting: st.visiting[:0]}
+	statePool.Put(st)
+}
+
+// canceled returns the error of the state's context once it is canceled or its deadline is exceeded.
+func (st *state) canceled() error {
+	select {
+	case <-st.done:
+		return st.ctx.Err()
+	default:
+		return nil
+	}
+}
+
+// location is the path of a value being verified, reported as the Field of its FieldErrors and, if the Validator was
+// created with WithJSONPointer, as their Pointer. It refers to a frame held by a state, so its path is only rendered
+// when it is reported rather than for every value verified. The zero location, other than pointers, is the root struct.
+type location struct {
+	// st holds the frame of the location at index i, or is nil for the root struct.
+	st *state
+	i  int
+	// pointers is set if pointer renders the JSON Pointer as well as name the path.
+	pointers bool
+}
+
+// frame is a single step of a location: a field of the struct at parent, or an element of the slice or array at
+// parent if index is not -1.
+type frame struct {
+	parent location
+	// name is the name of the field, and pointer the name escaped as a JSON Pointer reference token, preceded by /.
+	name    string
+	pointer string
+	index   int
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// pointerToken returns the part of a JSON Pointer naming the field called name.
+func pointerToken(name string) string {
+	return "/" + pointerEscaper.Replace(name)
+}
+
+// field returns the location of the field of the struct at l with the given name and pointerToken. It remains valid
+// until it is passed to release.
+func (st *state) field(l location, name, pointer string) location {
+	st.frames = append(st.frames, frame{parent: l, name: name, pointer: pointer, index: -1})
+	return location{st: st, i: len(st.frames) - 1, pointers: l.pointers}
+}
+
+// index returns the location of element i of the slice or array at l. It remains valid until it is passed to release.
+func (st *state) index(l location, i int) location {
+	st.frames = append(st.frames, frame{parent: l, index: i})
+	return location{st: st, i: len(st.frames) - 1, pointers: l.pointers}
+}
+
+// release discards loc, and every location reached from it, once its value has been verified. loc must have been
+// returned by st.
+func (st *state) release(loc location) {
+	st.frames = st.frames[:loc.i]
+}
+
+// name renders the path of l, such as Items[2].SKU.
+func (l location) name() string {
+	if l.st == nil {
+		return ""
+	}
+	if fr := &l.st.frames[l.i]; fr.index == -1 && fr.parent.st == nil {
+		// a field of the root struct is named by its own name, which needs no copying
+		return fr.name
+	}
+	return string(l.appendName(nil))
+}
+
+func (l location) appendName(b []byte) []byte {
+	if l.st == nil {
+		return b
+	}
+	fr := &l.st.frames[l.i]
+	b = fr.parent.appendName(b)
+	if fr.index != -1 {
+		b = append(b, '[')
+		b = strconv.AppendInt(b, int64(fr.index), 10)
+		return append(b, ']')
+	}
+	if len(b) > 0 {
+		b = append(b, '.')
+	}
+	return append(b, fr.name...)
+}
+
+// pointer renders the JSON Pointer of l, such as /Items/2/SKU, or returns an empty string if the Validator was not
+// created with WithJSONPointer.
+func (l location) pointer() string {
+	if !l.pointers || l.st == nil {
+		return ""
+	}
+	return string(l.appendPointer(nil))
+}
+
+func (l location) appendPointer(b []byte) []byte {
+	if l.st == nil {
+		return b
+	}
+	fr := &l.st.frames[l.i]
+	b = fr.parent.appendPointer(b)
+	if fr.index != -1 {
+		b = append(b, '/')
+		return strconv.AppendInt(b, int64(fr.index), 10)
+	}
+	return append(b, fr.pointer...)
+}
+
+// fieldPath renders the path and JSON Pointer of the field called name of the struct at l, for a field reported by
+// user code rather than verified by its tags.
+func (l location) fieldPath(name string) (string, string) {
+	path, pointer := l.name(), l.pointer()
+	if path != "" {
+		path += "."
+	}
+	if l.pointers {
+		pointer += pointerToken(name)
+	}
+	return path + name, pointer
+}
+
+// verify verifies rv with the compiled rules sr. The Err of the Result is a *ValidationError if any field fails.
+func (vd *Validator) verify(st *state, sr *structRules, rv reflect.Value) Result {
+	if sr.unexported && !rv.CanAddr() && rv.CanInterface() {
+		// unexported fields can only be read through their address
+		cp := reflect.New(rv.Type()).Elem()
+		cp.Set(rv)
+		rv = cp
+	}
+	root := location{pointers: vd.jsonPointer}
+	if err := vd.verifyStruct(st, sr, rv, root); err != nil {
+		return Result{Err: err}
+	}
+	if sr.verifier && !vd.stop(st) {
+		vd.report(st, rv.Type(), callVerifier(rv, root))
+	}
+	return vd.result(st)
+}
+
+// verifyStruct verifies every compiled field of rv, the struct at loc, adding the FieldErrors of all fields that fail
+// to st. A struct that is already being verified is skipped, so cycles end. An error is only returned if verification
+// was aborted.
+func (vd *Validator) verifyStruct(st *state, sr *structRules, rv reflect.Value, loc location) error {
+	if !st.enterStruct(rv) {
+		return nil
+	}
+	defer st.leaveStruct(rv)
+	base := vd.fieldBase(st, rv)
+	for i := range sr.fields {
+		if err := st.canceled(); err != nil {
+			return err
+		}
+		fr := &sr.fields[i]
+		// a field that fails is verified again through reflect, which reports its FieldErrors
+		if base != nil && fr.fast != nil && fr.fast(unsafe.Add(base, fr.offset)) {
+			continue
+		}
+		floc := st.field(loc, fr.name, fr.pointer)
+		err := vd.verifyValue(st, &fr.valueRules, rv, rv.Field(fr.index), loc, floc)
+		st.release(floc)
+		if err != nil {
+			return err
+		}
+		if vd.stop(st) {
+			return nil
+		}
+	}
+
+	if sr.structFunc != nil {
+		sl := &StructLevel{Value: rv, ctx: st.ctx, vd: vd, loc: loc}
+		sr.structFunc(sl)
+		for _, fe := range sl.errs {
+			vd.appendValue(fe)
+		}
+		vd.report(st, rv.Type(), sl.errs)
+	}
+	return nil
+}
+
+// verifyValue verifies f, a field of the struct parent, against vr. loc is the location of f, and parentLoc the
+// location of parent. A FieldError is added to st for every rule the field fails, while an error is only returned if
+// verification was aborted.
+func (vd *Validator) verifyValue(st *state, vr *valueRules, parent, f reflect.Value, parentLoc, loc location) error {
+	orig := f
+	if vr.conv != nil {
+		f = reflect.ValueOf(vr.conv(f))
+	}
+	rules := vr.rules
+	omit := vr.omitempty && f.IsZero()
+	if omit {
+		rules = rules[:vr.omitFrom]
+	}
+	rec := -1
+	if len(vr.rules) > 0 {
+		rec = st.recordField(loc)
+	}
+	for i, r := range rules {
+		fe := r.verify(st, parent, f, loc)
+		if fe == nil {
+			st.recordRule(rec, RuleResult{Tag: r.tag, Param: r.param, Outcome: Passed})
+		} else {
+			fe.Pointer = loc.pointer()
+			if vr.conv != nil {
+				fe.Value = interfaceOf(orig)
+			}
+			if vr.secret {
+				fe.Value, fe.secret = Redacted, true
+			}
+			if r.template != "" {
+				fe.msg = renderMessage(r.template, fe.Field, r.param, fe.Value)
+			}
+			if vr.msg != "" {
+				fe.msg, fe.custom = fe.Field+" "+vr.msg, true
+			}
+			vd.appendValue(fe)
+			if r.warn {
+				st.warnings = append(st.warnings, fe)
+				st.recordRule(rec, RuleResult{Tag: r.tag, Param: r.param, Outcome: Warned, Error: fe})
+				vd.notify(typeOf(parent), fe, true)
+				continue
+			}
+			st.recordRule(rec, RuleResult{Tag: r.tag, Param: r.param, Outcome: Failed, Error: fe})
+			vd.notify(typeOf(parent), fe, false)
+			st.errs = append(st.errs, fe)
+			// a value that is missing is not verified any further
+			if vd.failMode != EvaluateAll || vd.stop(st) || r.presence() {
+				st.skipRules(rec, vr.rules[i+1:])
+				return nil
+			}
+		}
+	}
+	if omit {
+		st.skipRules(rec, vr.rules[vr.omitFrom:])
+		return nil
+	}
+
+	if vr.elem != nil && f.Len() > 0 {
+		if err := vd.countElements(st, f.Len(), loc); err != nil {
+			return err
+		}
+		if err := vd.descend(st, loc); err != nil {
+			return err
+		}
+		err := vd.verifyElems(st, vr.elem, parent, f, parentLoc, loc)
+		st.ascend()
+		if err != nil {
+			return err
+		}
+		if vd.stop(st) {
+			return nil
+		}
+	}
+
+	if vr.strct != nil {
+		// nil pointers have no fields to verify
+		if f.Kind() == reflect.Ptr {
+			if f.IsNil() {
+				return nil
+			}
+			f = f.Elem()
+		}
+		// the fields of an embedded struct are promoted, so they share the location of the outer struct's fields
+		structLoc := loc
+		if vr.embedded {
+			structLoc = parentLoc
+		}
+		if err := vd.descend(st, loc); err != nil {
+			return err
+		}
+		err := vd.verifyStruct(st, vr.strct, f, structLoc)
+		st.ascend()
+		if err != nil {
+			return err
+		}
+		if vd.stop(st) {
+			return nil
+		}
+	}
+
+	if vr.verifier {
+		vd.report(st, typeOf(parent), callVerifier(orig, loc))
+	}
+	return nil
+}
+
+// verifyElems verifies each element of f, the value at loc, against vr, splitting them between the Validator's workers
+// if there are enough of them.
+func (vd *Validator) verifyElems(st *state, vr *valueRules, parent, f reflect.Value, parentLoc, loc location) error {
+	if vd.parallel(st, f.Len()) {
+		return vd.verifyElemsParallel(st, vr, parent, f, parentLoc, loc)
+	}
+	for i := 0; i < f.Len(); i++ {
+		if err := st.canceled(); err != nil {
+			return err
+		}
+		eloc := st.index(loc, i)
+		err := vd.verifyValue(st, vr, parent, f.Index(i), parentLoc, eloc)
+		st.release(eloc)
+		if err != nil {
+			return err
+		}
+		if vd.stop(st) {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package verify_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestCompile(t *testing.T) {
+	type A struct {
+		A string `verify:"minSize=2"`
+	}
+	type B struct {
+		A bool `verify:"min=abc"`
+	}
+	type C struct {
+		A []C `verify:"maxSize=1,dive"`
+		B int `verify:"min=1"`
+	}
+
+	tests := []struct {
+		name    string
+		input   reflect.Type
+		wantErr bool
+	}{
+		{"bool type", reflect.TypeOf(true), true},
+		{"invalid tag", reflect.TypeOf(B{}), true},
+		{"struct type", reflect.TypeOf(A{}), false},
+		{"*struct type", reflect.TypeOf(&A{}), false},
+		{"self-referential type", reflect.TypeOf(C{}), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := verify.Compile(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestCompiledIt(t *testing.T) {
+	type A struct {
+		A []A `verify:"maxSize=1,dive"`
+		B int `verify:"min=1"`
+	}
+	type B struct {
+		B int `verify:"min=1"`
+	}
+
+	c, err := verify.Compile(reflect.TypeOf(A{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"bool input", true, true},
+		{"wrong struct input", B{1}, true},
+		{"field fails", A{B: 0}, true},
+		{"element fails", A{A: []A{{B: 0}}, B: 1}, true},
+		{"works", A{A: []A{{B: 1}}, B: 1}, false},
+		{"works *struct", &A{B: 1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := c.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	type A struct {
+		A string `verify:"minSize=2"`
+		B []A    `verify:"dive"`
+	}
+	type B struct {
+		A bool   `verify:"min=abc"`
+		B string `verify:"maxSize"`
+		C int    `verify:"minsize=1"`
+	}
+	type C struct {
+		B
+		A []B `verify:"dive"`
+	}
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		wantCount int
+	}{
+		{"bool input", true, 1},
+		{"valid struct", A{}, 0},
+		{"valid nil *struct", (*A)(nil), 0},
+		{"each mistake reported", B{}, 3},
+		{"embedded and element mistakes reported", C{}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.Check(tt.input)
+			got := 0
+			if err != nil {
+				got = len(strings.Split(err.Error(), "\n"))
+			}
+			if got != tt.wantCount {
+				t.Errorf("want %d mistakes, got %v", tt.wantCount, err)
+			}
+		})
+	}
+}
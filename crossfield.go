@@ -0,0 +1,321 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	tagEqField = "eqfield"
+	tagNeField = "nefield"
+	tagGtField = "gtfield"
+	tagLtField = "ltfield"
+)
+
+// The errors below are wrapped by each FieldError for a failed cross-field sub-tag.
+var (
+	ErrEqField = errors.New("verify: field is not equal to another field")
+	ErrNeField = errors.New("verify: field is equal to another field")
+	ErrGtField = errors.New("verify: field is not greater than another field")
+	ErrLtField = errors.New("verify: field is not less than another field")
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func init() {
+	builtinRules[tagEqField] = fieldComparison(ErrEqField, false, "%s must be equal to %s",
+		func(c int) bool { return c == 0 })
+	builtinRules[tagNeField] = fieldComparison(ErrNeField, false, "%s must not be equal to %s",
+		func(c int) bool { return c != 0 })
+	builtinRules[tagGtField] = fieldComparison(ErrGtField, true, "%s must be greater than %s",
+		func(c int) bool { return c > 0 })
+	builtinRules[tagLtField] = fieldComparison(ErrLtField, true, "%s must be less than %s",
+		func(c int) bool { return c < 0 })
+}
+
+// fieldComparison returns a ruleBuilder for a sub-tag that compares a field against the field of the same struct
+// named by its param. The field passes if pass returns true for the result of comparing it to the other field. ordered
+// is set if the sub-tag needs the fields to be ordered rather than only comparable. format describes a failure given
+// the names of both fields.
+func fieldComparison(sentinel error, ordered bool, format string, pass func(c int) bool) ruleBuilder {
+	return func(s ruleSpec) (*rule, error) {
+		if !s.hasParam || s.param == "" {
+			return nil, fmt.Errorf("%s must specify a field", s.tag)
+		}
+		other, err := lookupOtherField(s, s.param)
+		if err != nil {
+			return nil, err
+		}
+		if other.typ != s.typ {
+			return nil, fmt.Errorf("%s type %s does not match %s type %s", s.field, s.typ, s.param, other.typ)
+		}
+		cmp, isOrdered := comparison(s.typ)
+		if cmp == nil || (ordered && !isOrdered) {
+			return nil, fmt.Errorf("%s can not be used with type %s", s.tag, s.typ)
+		}
+
+		return &rule{
+			tag:   s.tag,
+			param: s.param,
+			err:   sentinel,
+			checkField: func(parent, f reflect.Value) bool {
+				o := other.value(parent)
+				// a type that is comparable, such as an interface, may hold values that are not, which can not be
+				// compared either way
+				if !isOrdered && !(f.Comparable() && o.Comparable()) {
+					return false
+				}
+				return pass(cmp(f, o))
+			},
+			msg: func(name string) string { return fmt.Sprintf(format, name, s.param) },
+		}, nil
+	}
+}
+
+// comparison returns a function comparing two values of type t, returning a negative number, zero, or a positive
+// number if the first is less than, equal to, or greater than the second. ordered reports whether that order is
+// meaningful; for types that are only comparable the function returns 1 for values that are not equal, or that hold
+// values that can not be compared. A nil function
+// is returned for types that are not comparable.
+func comparison(t reflect.Type) (cmp func(a, b reflect.Value) int, ordered bool) {
+	if t == timeType {
+		return func(a, b reflect.Value) int {
+			return readable(a).Interface().(time.Time).Compare(readable(b).Interface().(time.Time))
+		}, true
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b reflect.Value) int { return compareOrdered(a.Int(), b.Int()) }, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b reflect.Value) int { return compareOrdered(a.Uint(), b.Uint()) }, true
+	case reflect.Float32, reflect.Float64:
+		return func(a, b reflect.Value) int { return compareOrdered(a.Float(), b.Float()) }, true
+	case reflect.String:
+		return func(a, b reflect.Value) int { return strings.Compare(a.String(), b.String()) }, true
+	}
+	if t.Comparable() {
+		return func(a, b reflect.Value) int {
+			if !a.Comparable() || !b.Comparable() {
+				return 1
+			}
+			if readable(a).Interface() == readable(b).Interface() {
+				return 0
+			}
+			return 1
+		}, false
+	}
+	return nil, false
+}
+
+func compareOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+const (
+	tagRequiredIf      = "required_if"
+	tagRequiredUnless  = "required_unless"
+	tagRequiredWith    = "required_with"
+	tagRequiredWithout = "required_without"
+)
+
+func init() {
+	builtinRules[tagRequiredIf] = conditionalRequired(false)
+	builtinRules[tagRequiredUnless] = conditionalRequired(true)
+	builtinRules[tagRequiredWith] = presenceRequired(false)
+	builtinRules[tagRequiredWithout] = presenceRequired(true)
+}
+
+// otherField is another field of a struct that a cross-field sub-tag refers to.
+type otherField struct {
+	name  string
+	typ   reflect.Type
+	index []int
+}
+
+// lookupOtherField returns the field of s.parent called name.
+func lookupOtherField(s ruleSpec, name string) (otherField, error) {
+	if s.parent == nil {
+		return otherField{}, fmt.Errorf("%s can only be used on struct fields", s.tag)
+	}
+	sf, ok := s.parent.FieldByName(name)
+	if !ok {
+		return otherField{}, fmt.Errorf("%s field %s does not exist on %s", s.tag, name, s.parent)
+	}
+	return otherField{name: name, typ: sf.Type, index: sf.Index}, nil
+}
+
+// value returns the value of the field in parent, or its zero value if it is promoted through a nil embedded pointer.
+func (o otherField) value(parent reflect.Value) reflect.Value {
+	v, err := parent.FieldByIndexErr(o.index)
+	if err != nil {
+		return reflect.Zero(o.typ)
+	}
+	return v
+}
+
+// requiredRule builds a required rule for the field of s, which a conditional sub-tag applies only when its condition
+// is met.
+func requiredRule(s ruleSpec) (*rule, error) {
+	r, err := buildRequired(s)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, fmt.Errorf("%s can not be used with type %s", s.tag, s.typ)
+	}
+	return r, nil
+}
+
+// conditionalRequired returns a ruleBuilder for a sub-tag whose param is a list of field names each followed by a
+// value, such as `required_if=Type card`. The field is required if every named field equals its value, or if unless is
+// set, unless every named field equals its value.
+func conditionalRequired(unless bool) ruleBuilder {
+	return func(s ruleSpec) (*rule, error) {
+		params := strings.Fields(s.param)
+		if len(params) == 0 || len(params)%2 != 0 {
+			return nil, fmt.Errorf("%s must specify pairs of a field and a value", s.tag)
+		}
+		required, err := requiredRule(s)
+		if err != nil {
+			return nil, err
+		}
+
+		type condition struct {
+			field otherField
+			value reflect.Value
+			cmp   func(a, b reflect.Value) int
+		}
+		conds := make([]condition, 0, len(params)/2)
+		for i := 0; i < len(params); i += 2 {
+			o, err := lookupOtherField(s, params[i])
+			if err != nil {
+				return nil, err
+			}
+			v, err := parseValue(o.typ, params[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("%s value %q can not be used with field %s: %w", s.tag, params[i+1], o.name, err)
+			}
+			cmp, _ := comparison(o.typ)
+			conds = append(conds, condition{field: o, value: v, cmp: cmp})
+		}
+
+		word := "when"
+		if unless {
+			word = "unless"
+		}
+		var desc []string
+		for i := 0; i < len(params); i += 2 {
+			desc = append(desc, params[i]+" is "+params[i+1])
+		}
+		msg := fmt.Sprintf("is required %s %s", word, strings.Join(desc, " and "))
+
+		return &rule{
+			tag:   s.tag,
+			param: s.param,
+			err:   ErrRequired,
+			checkField: func(parent, f reflect.Value) bool {
+				match := true
+				for _, c := range conds {
+					if c.cmp(c.field.value(parent), c.value) != 0 {
+						match = false
+						break
+					}
+				}
+				if match == unless {
+					return true
+				}
+				return required.check(f)
+			},
+			msg: func(name string) string { return name + " " + msg },
+		}, nil
+	}
+}
+
+// presenceRequired returns a ruleBuilder for a sub-tag whose param is a list of field names, such as
+// `required_with=Street City`. The field is required if any named field is set, or if without is set, if any named
+// field is not set.
+func presenceRequired(without bool) ruleBuilder {
+	return func(s ruleSpec) (*rule, error) {
+		names := strings.Fields(s.param)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("%s must specify a field", s.tag)
+		}
+		required, err := requiredRule(s)
+		if err != nil {
+			return nil, err
+		}
+		others := make([]otherField, len(names))
+		for i, name := range names {
+			if others[i], err = lookupOtherField(s, name); err != nil {
+				return nil, err
+			}
+		}
+
+		msg := fmt.Sprintf("is required when %s is set", strings.Join(names, " or "))
+		if without {
+			msg = fmt.Sprintf("is required when %s is not set", strings.Join(names, " or "))
+		}
+
+		return &rule{
+			tag:   s.tag,
+			param: s.param,
+			err:   ErrRequired,
+			checkField: func(parent, f reflect.Value) bool {
+				for _, o := range others {
+					if o.value(parent).IsZero() == without {
+						return required.check(f)
+					}
+				}
+				return true
+			},
+			msg: func(name string) string { return name + " " + msg },
+		}, nil
+	}
+}
+
+// parseValue parses s into a value of type t, which must be a bool, int, uint, float, or string type.
+func parseValue(t reflect.Type, s string) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, parseBase, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, parseBase, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetFloat(f)
+	default:
+		return reflect.Value{}, fmt.Errorf("type %s is not supported", t)
+	}
+	return v, nil
+}
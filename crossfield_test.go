@@ -0,0 +1,270 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codyoss/verify"
+)
+
+func TestCrossField(t *testing.T) {
+	type Window struct {
+		Start time.Time
+	}
+	type A struct {
+		*Window
+		Password        string
+		PasswordConfirm string    `verify:"eqfield=Password"`
+		Old             int       `verify:"nefield=New"`
+		New             int       `verify:"ltfield=Max"`
+		Max             int       `verify:"gtfield=Min"`
+		Min             int       `verify:"ltfield=Max"`
+		End             time.Time `verify:"gtfield=Start"`
+	}
+
+	now := time.Now()
+	valid := A{
+		Window:          &Window{now},
+		Password:        "a",
+		PasswordConfirm: "a",
+		Old:             1,
+		New:             2,
+		Max:             3,
+		Min:             1,
+		End:             now.Add(time.Hour),
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(a *A)
+		wantErr error
+	}{
+		{"works", func(a *A) {}, nil},
+		{"not equal", func(a *A) { a.PasswordConfirm = "b" }, verify.ErrEqField},
+		{"equal", func(a *A) { a.Old = 2 }, verify.ErrNeField},
+		{"not greater", func(a *A) { a.Max = 1 }, verify.ErrGtField},
+		{"not less", func(a *A) { a.New = 3 }, verify.ErrLtField},
+		{"time not after", func(a *A) { a.End = now }, verify.ErrGtField},
+		{"works nil embedded pointer", func(a *A) { a.Window = nil }, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := valid
+			tt.modify(&a)
+			got := verify.It(a)
+			if (tt.wantErr == nil && got != nil) || (tt.wantErr != nil && !errors.Is(got, tt.wantErr)) {
+				t.Errorf("want %v, got %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestCrossFieldUnexported(t *testing.T) {
+	type A struct {
+		start time.Time
+		end   time.Time `verify:"gtfield=start"`
+		min   int
+		max   int `verify:"gtfield=min"`
+		code  [2]byte
+		again [2]byte `verify:"eqfield=code"`
+	}
+
+	now := time.Now()
+	valid := A{start: now, end: now.Add(time.Hour), min: 1, max: 2, code: [2]byte{1, 2}, again: [2]byte{1, 2}}
+
+	tests := []struct {
+		name    string
+		modify  func(a *A)
+		wantErr error
+	}{
+		{"works", func(a *A) {}, nil},
+		{"time not after", func(a *A) { a.end = now }, verify.ErrGtField},
+		{"not greater", func(a *A) { a.max = 1 }, verify.ErrGtField},
+		{"not equal", func(a *A) { a.again[1] = 3 }, verify.ErrEqField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := valid
+			tt.modify(&a)
+			// unexported fields are verified whether the struct is passed by value or through a pointer
+			for _, v := range []interface{}{a, &a} {
+				got := verify.It(v)
+				if (tt.wantErr == nil && got != nil) || (tt.wantErr != nil && !errors.Is(got, tt.wantErr)) {
+					t.Errorf("want %v, got %v", tt.wantErr, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCrossFieldInterface(t *testing.T) {
+	type A struct {
+		X  interface{}
+		Eq interface{} `verify:"eqfield=X"`
+		Ne interface{} `verify:"nefield=X"`
+	}
+
+	tests := []struct {
+		name    string
+		input   A
+		wantErr []error
+	}{
+		{"works", A{X: 1, Eq: 1, Ne: 2}, nil},
+		{"nil", A{Ne: 1}, nil},
+		{"not equal", A{X: 1, Eq: "1", Ne: 2}, []error{verify.ErrEqField}},
+		{"equal", A{X: 1, Eq: 1, Ne: 1}, []error{verify.ErrNeField}},
+		{"values that can not be compared", A{X: []int{1}, Eq: []int{1}, Ne: []int{2}},
+			[]error{verify.ErrEqField, verify.ErrNeField}},
+		{"struct holding a value that can not be compared", A{X: 1, Eq: 1, Ne: struct{ V interface{} }{[]int{1}}},
+			[]error{verify.ErrNeField}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			var ve *verify.ValidationError
+			if tt.wantErr == nil {
+				if got != nil {
+					t.Errorf("want nil, got %v", got)
+				}
+				return
+			}
+			if !errors.As(got, &ve) || len(ve.Errors) != len(tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, got)
+			}
+			for i, want := range tt.wantErr {
+				if !errors.Is(ve.Errors[i], want) {
+					t.Errorf("want %v, got %v", want, ve.Errors[i])
+				}
+			}
+		})
+	}
+
+	type Ordered struct {
+		X  interface{}
+		Gt interface{} `verify:"gtfield=X"`
+	}
+	var ce *verify.ConfigError
+	if err := verify.It(Ordered{X: 1, Gt: 2}); !errors.As(err, &ce) {
+		t.Errorf("expected gtfield on an interface to be a ConfigError, got %v", err)
+	}
+}
+
+func TestCrossFieldInvalid(t *testing.T) {
+	type A struct {
+		A int `verify:"eqfield"`
+	}
+	type B struct {
+		A int `verify:"eqfield=C"`
+	}
+	type C struct {
+		A int    `verify:"eqfield=B"`
+		B string `verify:"-"`
+	}
+	type D struct {
+		A bool `verify:"gtfield=B"`
+		B bool
+	}
+	type E struct {
+		A []int `verify:"eqfield=B"`
+		B []int
+	}
+
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{"missing field", A{}},
+		{"unknown field", B{}},
+		{"mismatched types", C{}},
+		{"unordered type", D{}},
+		{"incomparable type", E{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verify.Check(tt.input); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+
+	if err := verify.Var(1, "eqfield=A"); err == nil {
+		t.Error("expected an error using eqfield outside of a struct")
+	}
+}
+
+func TestConditionalRequired(t *testing.T) {
+	type Payment struct {
+		Type       string
+		Amount     int
+		CardNumber string `verify:"required_if=Type card"`
+		Reference  string `verify:"required_unless=Type card Amount 0"`
+		Street     string
+		City       string `verify:"required_with=Street"`
+		Email      string
+		Phone      string `verify:"required_without=Email"`
+	}
+
+	tests := []struct {
+		name    string
+		input   Payment
+		wantErr bool
+	}{
+		{"required_if fails", Payment{Type: "card", Amount: 0, Email: "a"}, true},
+		{"works required_if", Payment{Type: "card", CardNumber: "4242", Email: "a"}, false},
+		{"required_unless fails", Payment{Type: "cash", Email: "a"}, true},
+		{"required_unless fails on partial match", Payment{Type: "card", Amount: 5, CardNumber: "1", Email: "a"}, true},
+		{"works required_unless", Payment{Type: "cash", Reference: "r", Email: "a"}, false},
+		{"required_with fails", Payment{Type: "cash", Reference: "r", Street: "s", Email: "a"}, true},
+		{"works required_with", Payment{Type: "cash", Reference: "r", Street: "s", City: "c", Email: "a"}, false},
+		{"required_without fails", Payment{Type: "cash", Reference: "r"}, true},
+		{"works required_without", Payment{Type: "cash", Reference: "r", Phone: "p"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && !errors.Is(got, verify.ErrRequired)) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestConditionalRequiredInvalid(t *testing.T) {
+	type A struct {
+		A string `verify:"required_if=B"`
+		B string
+	}
+	type B struct {
+		A string `verify:"required_if=B abc"`
+		B int
+	}
+	type C struct {
+		A string `verify:"required_with"`
+	}
+	type D struct {
+		A string `verify:"required_without=Missing"`
+	}
+	type E struct {
+		A [2]int `verify:"required_with=B"`
+		B string
+	}
+
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{"missing value", A{}},
+		{"unparsable value", B{}},
+		{"missing field", C{}},
+		{"unknown field", D{}},
+		{"unsupported type", E{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verify.Check(tt.input); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Field is a field being verified by a custom validation function registered with RegisterValidation.
+type Field struct {
+	// Name is the name of the field.
+	Name string
+	// Value is the value of the field.
+	Value reflect.Value
+	// Param is the value specified for the sub-tag, for example abc for customerID=abc. It is empty if no value was
+	// specified.
+	Param string
+
+	ctx context.Context
+}
+
+// Context returns the context the field is being verified with. It is the context passed to ItContext, or
+// context.Background if verification was started without one.
+func (f Field) Context() context.Context {
+	return f.ctx
+}
+
+// RegisterValidation registers fn as the custom validation function for the sub-tag name on the default Validator used
+// by the package level functions. See Validator.RegisterValidation for details.
+func RegisterValidation(name string, fn func(Field) error) {
+	defaultValidator.RegisterValidation(name, fn)
+}
+
+// RegisterValidation registers fn as the custom validation function for the sub-tag name, so a tag like
+// `verify:"customerID"` dispatches to fn for each field it is used on. fn may be used on fields of any type and should
+// return a non-nil error describing why the field is invalid; the FieldError reported wraps that error. Registering a
+// name again replaces its function. RegisterValidation panics if name is empty, contains a comma or equals sign, or
+// is the name of a built-in sub-tag.
+func (vd *Validator) RegisterValidation(name string, fn func(Field) error) {
+	mustBeRegistrable("validation", name)
+
+	vd.mu.Lock()
+	defer vd.mu.Unlock()
+	if vd.custom == nil {
+		vd.custom = make(map[string]func(Field) error)
+	}
+	vd.custom[name] = fn
+	// rules compiled before now may have skipped name as an unknown sub-tag
+	vd.clearCache()
+}
+
+// lookupRule returns the ruleBuilder for the sub-tag name, if there is one. Custom validations take precedence over
+// registered formats of the same name.
+func (vd *Validator) lookupRule(name string) (ruleBuilder, bool) {
+	if build, ok := builtinRules[name]; ok {
+		return build, true
+	}
+
+	vd.mu.RLock()
+	fn, ok := vd.custom[name]
+	vd.mu.RUnlock()
+	if !ok {
+		return lookupFormat(name)
+	}
+	return func(s ruleSpec) (*rule, error) {
+		return &rule{tag: s.tag, param: s.param, custom: fn}, nil
+	}, true
+}
+
+// mustBeRegistrable panics if name cannot be registered as a sub-tag. kind describes what is being registered.
+func mustBeRegistrable(kind, name string) {
+	if name == "" || name == tagSkip || strings.ContainsAny(name, ",=") || strings.HasPrefix(name, warnPrefix) {
+		panic(fmt.Sprintf("verify: invalid %s name %q", kind, name))
+	}
+	if _, ok := builtinRules[name]; ok || name == tagDive || name == tagOmitEmpty || name == tagMsg ||
+		name == tagSecret {
+		panic(fmt.Sprintf("verify: %s name %q is reserved by a built-in sub-tag", kind, name))
+	}
+}
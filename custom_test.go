@@ -0,0 +1,92 @@
+package verify_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+var errNotCustomerID = errors.New("must start with the prefix")
+
+func customerID(f verify.Field) error {
+	if !strings.HasPrefix(f.Value.String(), f.Param) {
+		return errNotCustomerID
+	}
+	return nil
+}
+
+func TestRegisterValidation(t *testing.T) {
+	type A struct {
+		A string `verify:"required,customerID=cus_"`
+	}
+
+	v := verify.New()
+	if err := v.It(A{"abc"}); err != nil {
+		t.Fatalf("expected unknown sub-tags to be ignored, got %v", err)
+	}
+	v.RegisterValidation("customerID", customerID)
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"custom fails", A{"abc"}, true},
+		{"works", A{"cus_abc"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+
+	err := v.It(A{"abc"})
+	var fe *verify.FieldError
+	if !errors.As(err, &fe) || fe.Tag != "customerID" || fe.Param != "cus_" || !errors.Is(err, errNotCustomerID) {
+		t.Errorf("expected a FieldError wrapping the custom error, got %v", err)
+	}
+}
+
+func TestRegisterValidationContext(t *testing.T) {
+	type key struct{}
+	type A struct {
+		A string `verify:"tenant"`
+	}
+
+	verify.RegisterValidation("tenant", func(f verify.Field) error {
+		if f.Context().Value(key{}) != f.Value.String() {
+			return errors.New("does not match the tenant of the request")
+		}
+		return nil
+	})
+
+	ctx := context.WithValue(context.Background(), key{}, "acme")
+	if err := verify.ItContext(ctx, A{"acme"}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := verify.ItContext(ctx, A{"other"}); err == nil {
+		t.Error("expected an error")
+	}
+	if err := verify.It(A{"acme"}); err == nil {
+		t.Error("expected an error without the context value")
+	}
+}
+
+func TestRegisterValidationPanics(t *testing.T) {
+	for _, name := range []string{"", "a,b", "a=b", "min", "dive"} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic registering %q", name)
+				}
+			}()
+			verify.New().RegisterValidation(name, customerID)
+		})
+	}
+}
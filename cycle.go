@@ -0,0 +1,36 @@
+package verify
+
+import "reflect"
+
+// visit identifies a struct being verified by its address and type, as an embedded struct shares the address of the
+// struct holding it.
+type visit struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// enterStruct reports whether rv, a struct about to be verified, should be verified, and if so adds it to the structs
+// st is verifying. A struct reached again through a pointer while its fields are still being verified, as with a node
+// that is its own ancestor in a graph, is part of a cycle and is not verified again, so verification ends rather than
+// recursing forever. It should be followed by a call to leaveStruct if it returns true.
+func (st *state) enterStruct(rv reflect.Value) bool {
+	if !rv.CanAddr() {
+		return true
+	}
+	v := visit{addr: rv.UnsafeAddr(), typ: rv.Type()}
+	// the structs being verified form a path from the root, which is short enough to search
+	for _, seen := range st.visiting {
+		if seen == v {
+			return false
+		}
+	}
+	st.visiting = append(st.visiting, v)
+	return true
+}
+
+// leaveStruct removes rv from the structs st is verifying once its fields have been verified.
+func (st *state) leaveStruct(rv reflect.Value) {
+	if rv.CanAddr() {
+		st.visiting = st.visiting[:len(st.visiting)-1]
+	}
+}
@@ -0,0 +1,56 @@
+package verify_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+type cycleNode struct {
+	Name      string       `verify:"required"`
+	Parent    *cycleNode   `verify:""`
+	Neighbors []*cycleNode `verify:"dive"`
+}
+
+// CycleLink embeds a pointer to its own type, so its fields are promoted from a struct of the same type.
+type CycleLink struct {
+	*CycleLink
+	Name string `verify:"required"`
+}
+
+func TestCycles(t *testing.T) {
+	root := &cycleNode{Name: "root"}
+	child := &cycleNode{Parent: root}
+	root.Neighbors = []*cycleNode{child, root}
+	child.Neighbors = []*cycleNode{root, child}
+
+	shared := &cycleNode{}
+	dag := &cycleNode{Name: "dag", Neighbors: []*cycleNode{shared, shared}}
+
+	link := &CycleLink{}
+	link.CycleLink = link
+
+	tests := []struct {
+		name  string
+		input interface{}
+		want  []string
+	}{
+		{"cycle through dive", root, []string{"Neighbors[0].Name"}},
+		{"cycle through an embedded pointer", link, []string{"Name"}},
+		{"shared values are verified on each path", dag, []string{"Neighbors[0].Name", "Neighbors[1].Name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, vd := range []*verify.Validator{verify.New(), verify.New(verify.WithParallelDive(1, 2))} {
+				var got []string
+				for _, fe := range vd.It(tt.input).(*verify.ValidationError).Errors {
+					got = append(got, fe.Field)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("want failures of %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// The errors below are wrapped by each FieldError, based on the sub-tag that failed, so they can be matched with
+// errors.Is.
+var (
+	ErrMinSize    = errors.New("verify: field has a length less than minSize")
+	ErrMaxSize    = errors.New("verify: field has a length greater than maxSize")
+	ErrLen        = errors.New("verify: field does not have the length specified by len")
+	ErrMinRunes   = errors.New("verify: field has fewer characters than minRunes")
+	ErrMaxRunes   = errors.New("verify: field has more characters than maxRunes")
+	ErrMin        = errors.New("verify: field has a value less than min")
+	ErrMax        = errors.New("verify: field has a value greater than max")
+	ErrGt         = errors.New("verify: field has a value not greater than gt")
+	ErrLt         = errors.New("verify: field has a value not less than lt")
+	ErrBetween    = errors.New("verify: field has a value outside the range specified by between")
+	ErrMultipleOf = errors.New("verify: field has a value that is not a multiple of multipleOf")
+	ErrUnique     = errors.New("verify: field contains duplicate values")
+	ErrRequired   = errors.New("verify: field is required but is set to zero value")
+	ErrNotBlank   = errors.New("verify: field is blank")
+	ErrOneOf      = errors.New("verify: field is not one of the allowed values")
+	ErrEq         = errors.New("verify: field is not equal to the value specified by eq")
+	ErrNe         = errors.New("verify: field is equal to the value specified by ne")
+)
+
+// Redacted replaces the Value of each FieldError reported for a field tagged secret.
+const Redacted = "[REDACTED]"
+
+// errorCodes maps the sentinel error of each built-in sub-tag to the Code of its FieldErrors.
+var errorCodes = map[error]string{
+	ErrMinSize:     "MIN_SIZE",
+	ErrMaxSize:     "MAX_SIZE",
+	ErrLen:         "LEN",
+	ErrMinRunes:    "MIN_RUNES",
+	ErrMaxRunes:    "MAX_RUNES",
+	ErrMin:         "MIN",
+	ErrMax:         "MAX",
+	ErrGt:          "GT",
+	ErrLt:          "LT",
+	ErrBetween:     "RANGE",
+	ErrMultipleOf:  "MULTIPLE_OF",
+	ErrUnique:      "UNIQUE",
+	ErrRequired:    "REQUIRED",
+	ErrNotBlank:    "NOT_BLANK",
+	ErrOneOf:       "ONE_OF",
+	ErrEq:          "EQ",
+	ErrNe:          "NE",
+	ErrEqField:     "EQ_FIELD",
+	ErrNeField:     "NE_FIELD",
+	ErrGtField:     "GT_FIELD",
+	ErrLtField:     "LT_FIELD",
+	ErrRegex:       "REGEX",
+	ErrBefore:      "BEFORE",
+	ErrAfter:       "AFTER",
+	ErrFormat:      "FORMAT",
+	ErrStartsWith:  "STARTS_WITH",
+	ErrEndsWith:    "ENDS_WITH",
+	ErrContains:    "CONTAINS",
+	ErrExcludes:    "EXCLUDES",
+	ErrExcludesAll: "EXCLUDES_ALL",
+	ErrPassword:    "PASSWORD",
+	ErrFile:        "FILE",
+	ErrDir:         "DIR",
+}
+
+// FieldError describes a single sub-tag that a field failed.
+type FieldError struct {
+	// Field is the path of the field that failed: its name, preceded by the path of the element holding it if it is
+	// a field of a struct reached through dive, such as Items[2].SKU. The fields of embedded structs are named as
+	// fields of the outer struct.
+	Field string
+	// Tag is the sub-tag that failed, for example minSize.
+	Tag string
+	// Param is the value specified for the sub-tag, for example 5 for minSize=5. It is empty for sub-tags that do not
+	// take a value.
+	Param string
+	// Value is the value of the field at the time it was verified.
+	Value interface{}
+	// Code is a stable, machine-readable identifier of the failure, such as MIN_SIZE or REQUIRED, which does not
+	// change with the message. Sub-tags that verify the same thing share a code, so gte reports MIN, required_if
+	// reports REQUIRED, and between reports RANGE. Failures of custom validations, and failures reported by a struct
+	// validation function or Verifier, use their tag in upper snake case, for example CUSTOMER_ID for customerID.
+	Code string
+	// Pointer is the location of the field as an RFC 6901 JSON Pointer, such as /Items/2/SKU. It is only set by a
+	// Validator created with WithJSONPointer.
+	Pointer string
+
+	msg string
+	err error
+	// secret is set if Value has been replaced by Redacted.
+	secret bool
+	// valueLen is the length of the value appended to msg by WithValueInMessage.
+	valueLen int
+	// custom is set if msg was given by the msg sub-tag of the field, so it is not replaced by Translate.
+	custom bool
+}
+
+func newFieldError(f reflect.Value, name string, r *rule, msg string) *FieldError {
+	return &FieldError{
+		Field: name,
+		Tag:   r.tag,
+		Param: r.param,
+		Value: interfaceOf(f),
+		Code:  r.code,
+		msg:   msg,
+		err:   r.err,
+	}
+}
+
+// NewFieldError returns a FieldError reporting that the field failed tag with the value param, with msg as its message
+// and wrapping err. Its Code is set in the same way as for the built-in sub-tags, so passing ErrMinSize gives MIN_SIZE.
+// It allows code that verifies values without reflection, such as the Validate methods generated by verifygen, to
+// report failures identical to those of It.
+func NewFieldError(field, tag, param string, value interface{}, msg string, err error) *FieldError {
+	code, ok := errorCodes[err]
+	if !ok {
+		code = tagCode(tag)
+	}
+	return &FieldError{Field: field, Tag: tag, Param: param, Value: value, Code: code, msg: msg, err: err}
+}
+
+// ruleCode returns the Code of the FieldErrors reported by r.
+func ruleCode(r *rule) string {
+	if code, ok := errorCodes[r.err]; ok {
+		return code
+	}
+	return tagCode(r.tag)
+}
+
+// tagCode converts tag to upper snake case, such as CUSTOMER_ID for customerID, for use as a Code.
+func tagCode(tag string) string {
+	runes := []rune(tag)
+	var sb strings.Builder
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			sb.WriteByte('_')
+			continue
+		}
+		// a word starts at an upper case letter following a lower case letter or digit, or at the last upper case
+		// letter of an acronym followed by a lower case letter, as in IDNumber
+		if i > 0 && unicode.IsUpper(r) && (!unicode.IsUpper(runes[i-1]) ||
+			i+1 < len(runes) && unicode.IsLower(runes[i+1])) && runes[i-1] != '_' {
+			sb.WriteByte('_')
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+	return sb.String()
+}
+
+// interfaceOf returns the value held by f, or nil if it cannot be obtained without panicking.
+func interfaceOf(f reflect.Value) interface{} {
+	if f.IsValid() && f.CanInterface() {
+		return f.Interface()
+	}
+	return nil
+}
+
+func (e *FieldError) Error() string {
+	return e.msg
+}
+
+// message returns the message of e without the value appended by WithValueInMessage.
+func (e *FieldError) message() string {
+	return e.msg[:len(e.msg)-e.valueLen]
+}
+
+// Unwrap returns the sentinel error for the sub-tag that failed, for example ErrMinSize, or the error returned by a
+// custom validation function.
+func (e *FieldError) Unwrap() error {
+	return e.err
+}
+
+// ValidationError is returned by It when one or more fields fail their validation.
+type ValidationError struct {
+	// Errors holds a FieldError for each sub-tag that failed, in the order the fields were verified: each struct's fields
+	// in the order they are declared, with the elements of a slice or array in index order, followed by the failures
+	// reported by its struct validation function and then its Verify method. The sub-tags of a field that require it to be
+	// set, required and its conditional forms, are verified first, and the rest in the order they are written. The order
+	// only changes if the struct types do, so it is safe to compare against golden files.
+	Errors []*FieldError
+	// Truncated is set if more failures were found than the Validator reports, which is limited by WithMaxErrors.
+	Truncated bool
+}
+
+// maxPooledBuffer bounds the capacity of a buffer returned to bufferPool, so a single huge message does not keep its
+// memory alive.
+const maxPooledBuffer = 64 << 10
+
+// bufferPool holds the buffers messages are built in, so bursts of failing values do not each grow their own.
+var bufferPool = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+
+func (e *ValidationError) Error() string {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	defer func() {
+		if buf.Cap() <= maxPooledBuffer {
+			buf.Reset()
+			bufferPool.Put(buf)
+		}
+	}()
+	buf.WriteString("verify found the following errors: [")
+	for i, v := range e.Errors {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(v.Error())
+	}
+	if e.Truncated {
+		buf.WriteString(", ...")
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// Sort sorts the FieldErrors by the path of their field, comparing indexes such as Items[10] by number, so the order
+// does not depend on the order fields are declared in. Failures of the same field keep their relative order.
+func (e *ValidationError) Sort() {
+	sort.SliceStable(e.Errors, func(i, j int) bool {
+		return comparePaths(e.Errors[i].Field, e.Errors[j].Field) < 0
+	})
+}
+
+// comparePaths compares the field paths a and b, returning a negative number, zero, or a positive number if a sorts
+// before, the same as, or after b. Runs of digits are compared by their numeric value.
+func comparePaths(a, b string) int {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			na, nb := digitRun(a), digitRun(b)
+			// leading zeros do not change the value, and a longer run of the remaining digits is a larger number
+			da, db := strings.TrimLeft(a[:na], "0"), strings.TrimLeft(b[:nb], "0")
+			if c := compareOrdered(int64(len(da)), int64(len(db))); c != 0 {
+				return c
+			}
+			if c := strings.Compare(da, db); c != 0 {
+				return c
+			}
+			a, b = a[na:], b[nb:]
+			continue
+		}
+		if a[0] != b[0] {
+			return compareOrdered(int64(a[0]), int64(b[0]))
+		}
+		a, b = a[1:], b[1:]
+	}
+	return compareOrdered(int64(len(a)), int64(len(b)))
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// digitRun returns the length of the run of digits at the start of s.
+func digitRun(s string) int {
+	n := 0
+	for n < len(s) && isDigit(s[n]) {
+		n++
+	}
+	return n
+}
+
+// Unwrap returns the contained FieldErrors so each can be inspected with errors.Is and errors.As.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, v := range e.Errors {
+		errs[i] = v
+	}
+	return errs
+}
+
+// ConfigError is returned instead of a ValidationError when rules can not be compiled because of a mistake in them,
+// such as a sub-tag missing its value, used on a field of the wrong type, or with a value that can not be parsed. It
+// describes a bug in the program rather than invalid data, so a service may treat it as an internal error while
+// treating a ValidationError as a bad request.
+type ConfigError struct {
+	// Type is the struct type with the invalid tag, or nil if the rules passed to Var are invalid.
+	Type reflect.Type
+	// Field is the name of the field with the invalid tag, or empty if the rules passed to Var are invalid.
+	Field string
+	// Err describes the mistake.
+	Err error
+}
+
+func (e *ConfigError) Error() string {
+	if e.Type == nil {
+		return "verify: invalid rules: " + e.Err.Error()
+	}
+	return fmt.Sprintf("verify: invalid tag on %s.%s: %v", e.Type, e.Field, e.Err)
+}
+
+// Unwrap returns the error describing the mistake.
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// Messages returns the message of each FieldError keyed by the name of the field that failed, with the messages of
+// each field in the order they were reported.
+func (e *ValidationError) Messages() map[string][]string {
+	m := make(map[string][]string)
+	for _, v := range e.Errors {
+		m[v.Field] = append(m[v.Field], v.Error())
+	}
+	return m
+}
+
+// MarshalJSON encodes the ValidationError as a JSON object mapping the name of each field that failed to an array of
+// its messages, as returned by Messages, so it may be written directly as the body of an HTTP response.
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Messages())
+}
+
+// Messages returns the messages of the *ValidationError held by err keyed by field, as returned by
+// ValidationError.Messages. It returns nil if err does not hold a *ValidationError.
+func Messages(err error) map[string][]string {
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		return nil
+	}
+	return ve.Messages()
+}
@@ -0,0 +1,440 @@
+package verify_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestValidationError(t *testing.T) {
+	type A struct {
+		A int    `verify:"required"`
+		B string `verify:"minSize=2"`
+		C []int  `verify:"dive,max=3"`
+	}
+
+	err := verify.It(A{A: 0, B: "a", C: []int{1, 4}})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %T", err)
+	}
+
+	want := []verify.FieldError{
+		{Field: "A", Tag: "required", Param: "", Value: 0},
+		{Field: "B", Tag: "minSize", Param: "2", Value: "a"},
+		{Field: "C[1]", Tag: "max", Param: "3", Value: 4},
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %d: %v", len(want), len(ve.Errors), err)
+	}
+	for i, w := range want {
+		got := ve.Errors[i]
+		if got.Field != w.Field || got.Tag != w.Tag || got.Param != w.Param || got.Value != w.Value {
+			t.Errorf("error %d: want %+v, got %+v", i, w, *got)
+		}
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	type A struct {
+		A int    `verify:"required"`
+		B string `verify:"minSize=2"`
+	}
+
+	want := "verify found the following errors: [A is required but is set to zero value, B has a length less than 2]"
+	if err := verify.It(A{}); err == nil || err.Error() != want {
+		t.Errorf("want %q, got %v", want, err)
+	}
+}
+
+func TestValidationErrorIs(t *testing.T) {
+	type A struct {
+		A int    `verify:"required"`
+		B string `verify:"minSize=2"`
+	}
+
+	err := verify.It(A{})
+	for _, target := range []error{verify.ErrRequired, verify.ErrMinSize} {
+		if !errors.Is(err, target) {
+			t.Errorf("expected err to match %v", target)
+		}
+	}
+	for _, target := range []error{verify.ErrMaxSize, verify.ErrMin, verify.ErrMax} {
+		if errors.Is(err, target) {
+			t.Errorf("expected err not to match %v", target)
+		}
+	}
+
+	type B struct {
+		A bool `verify:"min=abc"`
+	}
+	if err := verify.It(B{}); err == nil || errors.Is(err, verify.ErrMin) {
+		t.Errorf("expected a configuration error not matching verify.ErrMin, got %v", err)
+	}
+}
+
+func TestValidationErrorUnwrap(t *testing.T) {
+	type A struct {
+		A int    `verify:"required"`
+		B string `verify:"minSize=2"`
+	}
+
+	err := verify.It(A{})
+	u, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("expected err to implement Unwrap() []error, got %T", err)
+	}
+	if errs := u.Unwrap(); len(errs) != 2 {
+		t.Fatalf("expected 2 wrapped errors, got %d", len(errs))
+	}
+
+	var fe *verify.FieldError
+	if !errors.As(err, &fe) || fe.Field != "A" {
+		t.Errorf("expected errors.As to find the first FieldError, got %v", fe)
+	}
+
+	joined := errors.Join(errors.New("decode failed"), err)
+	if !errors.Is(joined, verify.ErrMinSize) {
+		t.Error("expected a joined error to match verify.ErrMinSize")
+	}
+}
+
+func TestValidationErrorMessages(t *testing.T) {
+	type A struct {
+		A int    `verify:"min=1,max=-1"`
+		B string `verify:"minSize=2"`
+		C string `verify:"maxSize=2"`
+	}
+
+	err := verify.It(A{B: "a"})
+	want := map[string][]string{
+		"A": {"A has value less than min 1", "A has value greater than max -1"},
+		"B": {"B has a length less than 2"},
+	}
+	if got := verify.Messages(err); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if got := verify.Messages(fmt.Errorf("decoding: %w", err)); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected a wrapped error to be unwrapped, got %v", got)
+	}
+	if got := verify.Messages(errors.New("decode failed")); got != nil {
+		t.Errorf("expected nil for other errors, got %v", got)
+	}
+
+	b, jerr := json.Marshal(err)
+	if jerr != nil {
+		t.Fatal(jerr)
+	}
+	wantJSON := `{"A":["A has value less than min 1","A has value greater than max -1"],` +
+		`"B":["B has a length less than 2"]}`
+	if string(b) != wantJSON {
+		t.Errorf("want %s, got %s", wantJSON, b)
+	}
+}
+
+type codeVerifier struct{}
+
+func (codeVerifier) Verify() error { return errors.New("always fails") }
+
+func TestFieldErrorCode(t *testing.T) {
+	vd := verify.New()
+	vd.RegisterValidation("customerID", func(f verify.Field) error { return errors.New("unknown customer") })
+	vd.RegisterStructValidation(func(sl *verify.StructLevel) {
+		sl.ReportError("A", "afterStart", errors.New("must be after start"))
+	}, codeStruct{})
+
+	err := vd.It(codeStruct{B: "a", F: "a"})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []string{
+		"REQUIRED", "MIN_SIZE", "MIN", "RANGE", "REQUIRED", "CUSTOMER_ID", "PASSWORD", "VERIFY", "AFTER_START",
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i].Code; got != w {
+			t.Errorf("error %d (%s): want %s, got %s", i, ve.Errors[i].Tag, w, got)
+		}
+	}
+}
+
+func TestNewFieldError(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		err      error
+		wantCode string
+	}{
+		{"built-in sentinel", "gte", verify.ErrMin, "MIN"},
+		{"custom error", "customerID", errors.New("unknown customer"), "CUSTOMER_ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fe := verify.NewFieldError("A", tt.tag, "1", 0, "A failed", tt.err)
+			if fe.Field != "A" || fe.Tag != tt.tag || fe.Param != "1" || fe.Value != 0 || fe.Code != tt.wantCode {
+				t.Errorf("unexpected FieldError %+v", *fe)
+			}
+			if fe.Error() != "A failed" || !errors.Is(fe, tt.err) {
+				t.Errorf("want message %q wrapping %v, got %q", "A failed", tt.err, fe.Error())
+			}
+		})
+	}
+
+	err := verify.It(struct {
+		A int `verify:"gte=1"`
+	}{})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := verify.NewFieldError("A", "gte", "1", 0, "A has value less than min 1", verify.ErrMin)
+	if !reflect.DeepEqual(ve.Errors[0], want) {
+		t.Errorf("want %+v, got %+v", *want, *ve.Errors[0])
+	}
+}
+
+type codeStruct struct {
+	A int          `verify:"required"`
+	B string       `verify:"minSize=2"`
+	C int          `verify:"gte=1"`
+	D int          `verify:"between=1:5"`
+	E string       `verify:"required_if=B a"`
+	F string       `verify:"customerID,password"`
+	G codeVerifier `verify:""`
+}
+
+func TestFieldErrorPath(t *testing.T) {
+	type Audit struct {
+		CreatedBy string `verify:"required"`
+	}
+	type Item struct {
+		SKU  string   `verify:"required"`
+		Tags []string `verify:"dive,minSize=2"`
+	}
+	type Order struct {
+		Audit
+		Items [][]Item `verify:"dive,dive"`
+		Gifts []*Item  `verify:"dive"`
+	}
+	vd := verify.New()
+	vd.RegisterStructValidation(func(sl *verify.StructLevel) {
+		if sl.Value.Interface().(Item).SKU == "bad" {
+			sl.ReportError("SKU", "knownSKU", errors.New("unknown SKU"))
+		}
+	}, Item{})
+
+	err := vd.It(Order{
+		Items: [][]Item{{{SKU: "a"}}, {{SKU: "a"}, {Tags: []string{"ok", "x"}}}},
+		Gifts: []*Item{nil, {SKU: "bad"}},
+	})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []string{"CreatedBy", "Items[1][1].SKU", "Items[1][1].Tags[1]", "Gifts[1].SKU"}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i].Field; got != w {
+			t.Errorf("error %d: want %s, got %s", i, w, got)
+		}
+	}
+	if got := ve.Errors[1].Error(); got != "Items[1][1].SKU is required but is set to zero value" {
+		t.Errorf("expected the message to use the path, got %q", got)
+	}
+	if got := ve.Errors[3].Error(); got != "Gifts[1].SKU failed knownSKU: unknown SKU" {
+		t.Errorf("expected the struct validation message to use the path, got %q", got)
+	}
+}
+
+func TestFieldErrorPointer(t *testing.T) {
+	type Item struct {
+		SKU string `verify:"required"`
+	}
+	type A struct {
+		Name  string `verify:"required"`
+		Items []Item `verify:"dive"`
+		Codes []int  `verify:"dive,min=1"`
+	}
+
+	err := verify.New(verify.WithJSONPointer()).It(A{Items: []Item{{"a"}, {}}, Codes: []int{1, 0}})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []string{"/Name", "/Items/1/SKU", "/Codes/1"}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i].Pointer; got != w {
+			t.Errorf("error %d: want %s, got %s", i, w, got)
+		}
+	}
+
+	err = verify.New(verify.WithJSONPointer()).Var([]int{1, 0}, "dive,min=1")
+	if !errors.As(err, &ve) || ve.Errors[0].Pointer != "/1" {
+		t.Errorf("expected the pointer of an element of a standalone value, got %v", err)
+	}
+	err = verify.It(A{})
+	if !errors.As(err, &ve) || ve.Errors[0].Pointer != "" {
+		t.Errorf("expected no pointer without WithJSONPointer, got %q", ve.Errors[0].Pointer)
+	}
+}
+
+func TestFieldPathsAreOnlyRenderedOnFailure(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates, so allocations can not be counted")
+	}
+	type Item struct {
+		SKU  string `verify:"required"`
+		Qtys []int  `verify:"dive,min=1"`
+	}
+	type A struct {
+		Name  string   `verify:"required"`
+		Items [][]Item `verify:"dive,dive"`
+	}
+	input := &A{Name: "a", Items: [][]Item{{{SKU: "a", Qtys: []int{1, 2}}}, {{SKU: "b"}, {SKU: "c"}}}}
+
+	tests := []struct {
+		name string
+		vd   *verify.Validator
+	}{
+		{"paths", verify.New()},
+		{"JSON pointers", verify.New(verify.WithJSONPointer())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, func() {
+				if err := tt.vd.It(input); err != nil {
+					t.Fatal(err)
+				}
+			}); allocs != 0 {
+				t.Errorf("expected nested values that pass to not allocate, got %v allocations", allocs)
+			}
+		})
+	}
+}
+
+func TestWithFieldNameTag(t *testing.T) {
+	type Item struct {
+		SKU string `json:"sku" verify:"required"`
+	}
+	type A struct {
+		UserName string `json:"user_name,omitempty" verify:"required"`
+		Items    []Item `json:"items" verify:"dive"`
+		Email    string `json:"-" verify:"required"`
+		Phone    string `json:",omitempty" verify:"required"`
+		Ratio    string `json:"a/b~c" verify:"required"`
+		End      int    `json:"end"`
+	}
+	vd := verify.New(verify.WithFieldNameTag("json"), verify.WithJSONPointer())
+	vd.RegisterStructValidation(func(sl *verify.StructLevel) {
+		sl.ReportError("End", "afterStart", errors.New("must be after start"))
+	}, A{})
+
+	err := vd.It(A{Items: []Item{{}}})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []struct{ field, pointer string }{
+		{"user_name", "/user_name"},
+		{"items[0].sku", "/items/0/sku"},
+		{"Email", "/Email"},
+		{"Phone", "/Phone"},
+		{"a/b~c", "/a~1b~0c"},
+		{"end", "/end"},
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i]; got.Field != w.field || got.Pointer != w.pointer {
+			t.Errorf("error %d: want %s %s, got %s %s", i, w.field, w.pointer, got.Field, got.Pointer)
+		}
+	}
+	if got := ve.Errors[0].Error(); got != "user_name is required but is set to zero value" {
+		t.Errorf("expected the message to use the tag name, got %q", got)
+	}
+}
+
+func TestWithFieldNameFunc(t *testing.T) {
+	type A struct {
+		UserName string `json:"user_name" verify:"required"`
+		Email    string `json:"email" verify:"required"`
+		ID       string `verify:"required"`
+	}
+	vd := verify.New(verify.WithFieldNameTag("json"), verify.WithFieldNameFunc(func(sf reflect.StructField) string {
+		switch sf.Name {
+		case "UserName":
+			return "Nom d'utilisateur"
+		case "ID":
+			return "account.id"
+		}
+		return ""
+	}))
+
+	err := vd.It(A{})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []string{"Nom d'utilisateur", "email", "account.id"}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i].Field; got != w {
+			t.Errorf("error %d: want %s, got %s", i, w, got)
+		}
+	}
+}
+
+func TestConfigError(t *testing.T) {
+	type A struct {
+		A bool `verify:"min=1"`
+		B int  `verify:"minSize=abc"`
+	}
+
+	var ce *verify.ConfigError
+	err := verify.It(A{})
+	if !errors.As(err, &ce) || ce.Type != reflect.TypeOf(A{}) || ce.Field != "A" {
+		t.Fatalf("expected a *verify.ConfigError for field A, got %v", err)
+	}
+	var ve *verify.ValidationError
+	if errors.As(err, &ve) {
+		t.Error("expected a configuration error not to be a *verify.ValidationError")
+	}
+
+	err = verify.Check(A{})
+	u, ok := err.(interface{ Unwrap() []error })
+	if !ok || len(u.Unwrap()) != 2 {
+		t.Fatalf("expected a joined error of 2 mistakes, got %v", err)
+	}
+	for _, e := range u.Unwrap() {
+		if !errors.As(e, &ce) {
+			t.Errorf("expected a *verify.ConfigError, got %v", e)
+		}
+	}
+
+	err = verify.Var(1, "minSize=2")
+	if !errors.As(err, &ce) || ce.Type != nil || err.Error() != "verify: invalid rules: "+ce.Err.Error() {
+		t.Errorf("expected a *verify.ConfigError for Var, got %v", err)
+	}
+
+	type B struct {
+		A int `verify:"required"`
+	}
+	if err := verify.It(B{}); errors.As(err, &ce) {
+		t.Errorf("expected a failure not to be a *verify.ConfigError, got %v", err)
+	}
+}
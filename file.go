@@ -0,0 +1,57 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"reflect"
+)
+
+const (
+	tagFile = "file"
+	tagDir  = "dir"
+)
+
+// The errors below are wrapped by each FieldError for a path that does not exist or is not of the kind required.
+var (
+	ErrFile = errors.New("verify: field is not the path of an existing file")
+	ErrDir  = errors.New("verify: field is not the path of an existing directory")
+)
+
+func init() {
+	builtinRules[tagFile] = derefRule(pathRule(ErrFile, "file", false))
+	builtinRules[tagDir] = derefRule(pathRule(ErrDir, "directory", true))
+}
+
+// WithFS sets the file system the file and dir sub-tags resolve paths through, so tests may supply an fstest.MapFS.
+// Paths must then be valid for fsys as described by fs.ValidPath. By default paths are resolved on the operating
+// system's file system with os.Stat.
+func WithFS(fsys fs.FS) Option {
+	return func(vd *Validator) {
+		vd.fsys = fsys
+	}
+}
+
+// pathRule returns a ruleBuilder for a sub-tag verifying a string field is the path of an existing directory, or if
+// dir is not set, an existing file that is not a directory.
+func pathRule(sentinel error, kind string, dir bool) ruleBuilder {
+	return func(s ruleSpec) (*rule, error) {
+		if s.typ.Kind() != reflect.String {
+			return nil, fmt.Errorf("%s can only be used with types: string", s.tag)
+		}
+		stat := os.Stat
+		if fsys := s.vd.fsys; fsys != nil {
+			stat = func(name string) (fs.FileInfo, error) { return fs.Stat(fsys, name) }
+		}
+		return &rule{
+			tag: s.tag,
+			err: sentinel,
+			check: func(f reflect.Value) bool {
+				fi, err := stat(f.String())
+				return err == nil && fi.IsDir() == dir
+			},
+			msg: func(name string) string { return fmt.Sprintf("%s must be the path of an existing %s", name, kind) },
+		}, nil
+	}
+}
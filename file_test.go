@@ -0,0 +1,63 @@
+package verify_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/codyoss/verify"
+)
+
+func TestFileAndDir(t *testing.T) {
+	type A struct {
+		Config string  `verify:"file"`
+		Data   *string `verify:"dir"`
+	}
+
+	vd := verify.New(verify.WithFS(fstest.MapFS{
+		"etc/app.yaml":  {Data: []byte("a: 1")},
+		"var/data/blob": {Data: []byte("b")},
+	}))
+	dir, file, missing, abs := "var/data", "var/data/blob", "var/missing", "/etc/app.yaml"
+
+	tests := []struct {
+		name    string
+		input   A
+		wantErr error
+	}{
+		{"works", A{Config: "etc/app.yaml", Data: &dir}, nil},
+		{"works nil pointer", A{Config: "etc/app.yaml"}, nil},
+		{"file missing", A{Config: "etc/other.yaml"}, verify.ErrFile},
+		{"file is a directory", A{Config: "etc"}, verify.ErrFile},
+		{"path not valid for fs", A{Config: abs}, verify.ErrFile},
+		{"dir missing", A{Config: "etc/app.yaml", Data: &missing}, verify.ErrDir},
+		{"dir is a file", A{Config: "etc/app.yaml", Data: &file}, verify.ErrDir},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := vd.It(tt.input)
+			if (tt.wantErr == nil && got != nil) || (tt.wantErr != nil && !errors.Is(got, tt.wantErr)) {
+				t.Errorf("want %v, got %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestFileOS(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "a.txt")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify.Var(file, "file"); err != nil {
+		t.Errorf("want nil, got %v", err)
+	}
+	if err := verify.Var(dir, "dir"); err != nil {
+		t.Errorf("want nil, got %v", err)
+	}
+	if err := verify.Var(filepath.Join(dir, "b.txt"), "file"); !errors.Is(err, verify.ErrFile) {
+		t.Errorf("want ErrFile, got %v", err)
+	}
+}
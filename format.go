@@ -0,0 +1,136 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+const tagFormat = "format"
+
+// ErrFormat is wrapped by each FieldError for a field that is not in the format required by a format sub-tag, or by a
+// registered format used as a sub-tag of its own.
+var ErrFormat = errors.New("verify: field is not in the required format")
+
+var errValueTypeFormat = errors.New("format can only be used with types: string, []byte, or int and uint types")
+
+// FormatFunc returns a function reporting whether a string is in a format, given the value specified for the sub-tag,
+// for example https for url=https. param is empty if no value was specified. An error should be returned if param is
+// not valid for the format; it is reported when the struct type is compiled rather than when a value is verified.
+type FormatFunc func(param string) (func(s string) bool, error)
+
+var formats = struct {
+	sync.RWMutex
+	m map[string]FormatFunc
+}{m: make(map[string]FormatFunc)}
+
+// formatKey identifies a format built with a value in formatCache.
+type formatKey struct {
+	name  string
+	param string
+}
+
+// formatCache holds the checks built by each FormatFunc, keyed by the name of the format and the value it was built
+// with, so a format whose value is expensive to parse is only built once. It is bounded by SetPatternCacheSize.
+var formatCache = newLRUCache[formatKey, func(string) bool](defaultPatternCacheSize)
+
+func init() {
+	builtinRules[tagFormat] = derefRule(buildFormat)
+}
+
+// RegisterFormat registers valid as the check for the string format name, so it may be used by any Validator with a
+// tag like `verify:"format=sku"` or, unless a Validator has a validation of the same name, `verify:"sku"`. The
+// formats shipped with this module are registered by importing the github.com/codyoss/verify/formats package. Formats
+// should be registered from an init function, before any value is verified, as struct types compiled beforehand will
+// not see them. Registering a name again replaces its format. RegisterFormat panics if name is empty, contains a comma
+// or equals sign, or is the name of a built-in sub-tag.
+func RegisterFormat(name string, valid func(s string) bool) {
+	RegisterFormatFunc(name, func(param string) (func(string) bool, error) {
+		if param != "" {
+			return nil, fmt.Errorf("format %s does not take a value", name)
+		}
+		return valid, nil
+	})
+}
+
+// RegisterFormatFunc registers fn for the string format name in the same way as RegisterFormat, for formats that
+// take a value such as url=https. The value may only be given when the format is used as a sub-tag of its own.
+func RegisterFormatFunc(name string, fn FormatFunc) {
+	mustBeRegistrable("format", name)
+
+	formats.Lock()
+	defer formats.Unlock()
+	formats.m[name] = fn
+	// checks built by a format registered before under the same name must not be reused
+	formatCache.clear()
+}
+
+// lookupFormat returns the ruleBuilder for the format name, if one is registered.
+func lookupFormat(name string) (ruleBuilder, bool) {
+	formats.RLock()
+	fn, ok := formats.m[name]
+	formats.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return derefRule(func(s ruleSpec) (*rule, error) {
+		return formatRule(s, name, fn, s.param)
+	}), true
+}
+
+func buildFormat(s ruleSpec) (*rule, error) {
+	if !s.hasParam || s.param == "" {
+		return nil, errors.New("format must specify the name of a format")
+	}
+	formats.RLock()
+	fn, ok := formats.m[s.param]
+	formats.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%s uses format %q, which is not registered", s.field, s.param)
+	}
+	return formatRule(s, s.param, fn, "")
+}
+
+// formatRule builds a rule verifying the field of s is in the format built by fn with param.
+func formatRule(s ruleSpec, format string, fn FormatFunc, param string) (*rule, error) {
+	str, ok := formatString(s.typ)
+	if !ok {
+		return nil, errValueTypeFormat
+	}
+	key := formatKey{name: format, param: param}
+	valid, ok := formatCache.get(key)
+	if !ok {
+		var err error
+		if valid, err = fn(param); err != nil {
+			return nil, fmt.Errorf("%s: %w", s.field, err)
+		}
+		valid = formatCache.add(key, valid)
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrFormat,
+		check: func(f reflect.Value) bool { return valid(str(f)) },
+		msg:   func(name string) string { return fmt.Sprintf("%s is not a valid %s", name, format) },
+	}, nil
+}
+
+// formatString returns a function converting values of t to the string checked by a format. Integers are formatted
+// in base 10, so formats such as port may be used on numeric fields.
+func formatString(t reflect.Type) (func(f reflect.Value) string, bool) {
+	switch t.Kind() {
+	case reflect.String:
+		return reflect.Value.String, true
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return func(f reflect.Value) string { return string(f.Bytes()) }, true
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(f reflect.Value) string { return strconv.FormatInt(f.Int(), parseBase) }, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(f reflect.Value) string { return strconv.FormatUint(f.Uint(), parseBase) }, true
+	}
+	return nil, false
+}
@@ -0,0 +1,101 @@
+package verify_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func init() {
+	verify.RegisterFormat("sku", func(s string) bool { return strings.HasPrefix(s, "SKU-") })
+	verify.RegisterFormatFunc("prefixed", func(param string) (func(string) bool, error) {
+		if param == "" {
+			return nil, errors.New("prefixed must specify a prefix")
+		}
+		return func(s string) bool { return strings.HasPrefix(s, param) }, nil
+	})
+}
+
+func TestRegisterFormat(t *testing.T) {
+	type A struct {
+		A string  `verify:"format=sku"`
+		B *string `verify:"sku"`
+		C int     `verify:"prefixed=4"`
+	}
+	sku, bad := "SKU-1", "1"
+
+	tests := []struct {
+		name    string
+		input   A
+		wantErr bool
+	}{
+		{"works", A{A: sku, B: &sku, C: 42}, false},
+		{"works nil pointer", A{A: sku, C: 4}, false},
+		{"format fails", A{A: bad, C: 4}, true},
+		{"sub-tag fails", A{A: sku, B: &bad, C: 4}, true},
+		{"int fails", A{A: sku, C: 24}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+
+	err := verify.It(A{A: bad, C: 4})
+	if !errors.Is(err, verify.ErrFormat) {
+		t.Errorf("want ErrFormat, got %v", err)
+	}
+	if want := "verify found the following errors: [A is not a valid sku]"; err == nil || err.Error() != want {
+		t.Errorf("got %v, want %q", err, want)
+	}
+}
+
+func TestRegisterFormatConfig(t *testing.T) {
+	type A struct {
+		A string `verify:"format=missing"`
+	}
+	type B struct {
+		A string `verify:"format"`
+	}
+	type C struct {
+		A bool `verify:"sku"`
+	}
+	type D struct {
+		A string `verify:"sku=1"`
+	}
+	type E struct {
+		A string `verify:"prefixed"`
+	}
+	for _, v := range []interface{}{A{}, B{}, C{}, D{}, E{}} {
+		if err := verify.Check(v); err == nil {
+			t.Errorf("Check(%T) want an error, got nil", v)
+		}
+	}
+}
+
+func TestRegisterFormatValidationPrecedence(t *testing.T) {
+	vd := verify.New()
+	vd.RegisterValidation("sku", func(verify.Field) error { return fmt.Errorf("always fails") })
+	if err := vd.Var("SKU-1", "sku"); err == nil {
+		t.Error("want the validation to take precedence over the format")
+	}
+}
+
+func TestRegisterFormatPanics(t *testing.T) {
+	for _, name := range []string{"", "a,b", "min", "format"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterFormat(%q) did not panic", name)
+				}
+			}()
+			verify.RegisterFormat(name, func(string) bool { return true })
+		}()
+	}
+}
@@ -0,0 +1,45 @@
+package formats
+
+// Alpha reports whether s is a non-empty string of ASCII letters.
+func Alpha(s string) bool {
+	return s != "" && every(s, isAlpha)
+}
+
+// Alphanum reports whether s is a non-empty string of ASCII letters and digits.
+func Alphanum(s string) bool {
+	return s != "" && every(s, func(c byte) bool { return isAlpha(c) || isDigit(c) })
+}
+
+// Numeric reports whether s is a non-empty string of the digits 0 to 9, such as a code that may have leading zeros.
+// Signs, decimal points, and separators are not accepted.
+func Numeric(s string) bool {
+	return s != "" && every(s, isDigit)
+}
+
+// ASCII reports whether s contains only ASCII characters, including control characters.
+func ASCII(s string) bool {
+	return every(s, func(c byte) bool { return c < 0x80 })
+}
+
+// PrintASCII reports whether s contains only printable ASCII characters, from space to tilde.
+func PrintASCII(s string) bool {
+	return every(s, func(c byte) bool { return ' ' <= c && c <= '~' })
+}
+
+// every reports whether every byte of s satisfies f.
+func every(s string, f func(c byte) bool) bool {
+	for i := 0; i < len(s); i++ {
+		if !f(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
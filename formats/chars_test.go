@@ -0,0 +1,39 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestCharacterClasses(t *testing.T) {
+	tests := []struct {
+		name                                 string
+		input                                string
+		alpha, alphanum, numeric, ascii, pra bool
+	}{
+		{"letters", "Gopher", true, true, false, true, true},
+		{"letters and digits", "gopher42", false, true, false, true, true},
+		{"digits", "007", false, true, true, true, true},
+		{"signed number", "-1.5", false, false, false, true, true},
+		{"space", "go pher", false, false, false, true, true},
+		{"control character", "go\tpher", false, false, false, true, false},
+		{"non-ASCII letter", "café", false, false, false, false, false},
+		{"empty", "", false, false, false, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for rules, want := range map[string]bool{
+				"alpha":      tt.alpha,
+				"alphanum":   tt.alphanum,
+				"numeric":    tt.numeric,
+				"ascii":      tt.ascii,
+				"printascii": tt.pra,
+			} {
+				if got := verify.Var(tt.input, rules) == nil; got != want {
+					t.Errorf("%s got %v, want %v", rules, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package formats
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cardBrand describes the numbers issued by a card brand.
+type cardBrand struct {
+	name string
+	// prefixes are inclusive ranges of the leading digits of a number, each bound having the same number of digits.
+	prefixes [][2]string
+	lengths  []int
+}
+
+var cardBrands = []cardBrand{
+	{"visa", [][2]string{{"4", "4"}}, []int{13, 16, 19}},
+	{"mastercard", [][2]string{{"51", "55"}, {"2221", "2720"}}, []int{16}},
+	{"amex", [][2]string{{"34", "34"}, {"37", "37"}}, []int{15}},
+	{"discover", [][2]string{{"6011", "6011"}, {"644", "649"}, {"65", "65"}}, []int{16, 17, 18, 19}},
+	{"diners", [][2]string{{"300", "305"}, {"36", "36"}, {"38", "39"}}, []int{14, 15, 16, 17, 18, 19}},
+	{"jcb", [][2]string{{"3528", "3589"}}, []int{16, 17, 18, 19}},
+	{"unionpay", [][2]string{{"62", "62"}}, []int{16, 17, 18, 19}},
+}
+
+// creditCardFormat builds the creditcard format. param is a space separated list of the brands allowed, or empty to
+// allow any.
+func creditCardFormat(param string) (func(string) bool, error) {
+	names := strings.Fields(param)
+	if len(names) == 0 {
+		return CreditCard, nil
+	}
+	allowed := make(map[string]bool, len(names))
+	for _, name := range names {
+		if !knownBrand(name) {
+			return nil, fmt.Errorf("creditcard brand %q is not one of visa, mastercard, amex, discover, diners, jcb, "+
+				"or unionpay", name)
+		}
+		allowed[name] = true
+	}
+	return func(s string) bool {
+		brand, ok := CardBrand(s)
+		return ok && allowed[brand]
+	}, nil
+}
+
+func knownBrand(name string) bool {
+	for _, b := range cardBrands {
+		if b.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CreditCard reports whether s is a payment card number of a known brand that passes the Luhn checksum. The digits may
+// be separated by spaces or hyphens.
+func CreditCard(s string) bool {
+	_, ok := CardBrand(s)
+	return ok
+}
+
+// CardBrand returns the brand of the payment card number s, one of visa, mastercard, amex, discover, diners, jcb, or
+// unionpay, based on its leading digits and length. It reports false if s is not a card number of a known brand that
+// passes the Luhn checksum. The digits may be separated by spaces or hyphens.
+func CardBrand(s string) (string, bool) {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(s)
+	if !luhn(digits) {
+		return "", false
+	}
+	for _, b := range cardBrands {
+		if b.matches(digits) {
+			return b.name, true
+		}
+	}
+	return "", false
+}
+
+func (b cardBrand) matches(digits string) bool {
+	lengthOK := false
+	for _, n := range b.lengths {
+		lengthOK = lengthOK || len(digits) == n
+	}
+	if !lengthOK {
+		return false
+	}
+	for _, p := range b.prefixes {
+		lead := digits[:len(p[0])]
+		if p[0] <= lead && lead <= p[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// luhn reports whether digits is a non-empty string of digits whose Luhn checksum is valid.
+func luhn(digits string) bool {
+	if digits == "" {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,66 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+	"github.com/codyoss/verify/formats"
+)
+
+func TestCreditCard(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantBrand string
+	}{
+		{"visa", "4111111111111111", "visa"},
+		{"visa separated", "4111 1111-1111 1111", "visa"},
+		{"mastercard", "5555555555554444", "mastercard"},
+		{"mastercard 2-series", "2223003122003222", "mastercard"},
+		{"amex", "378282246310005", "amex"},
+		{"discover", "6011111111111117", "discover"},
+		{"diners", "30569309025904", "diners"},
+		{"jcb", "3530111333300000", "jcb"},
+		{"unionpay", "6200000000000005", "unionpay"},
+		{"bad checksum", "4111111111111112", ""},
+		{"unknown prefix", "9111111111111111", ""},
+		{"wrong length for brand", "41111111111111111", ""},
+		{"letters", "4111a11111111111", ""},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			brand, ok := formats.CardBrand(tt.input)
+			if brand != tt.wantBrand || ok != (tt.wantBrand != "") {
+				t.Errorf("CardBrand got %q %v, want %q", brand, ok, tt.wantBrand)
+			}
+			if got := verify.Var(tt.input, "creditcard") == nil; got != (tt.wantBrand != "") {
+				t.Errorf("creditcard got %v, want %v", got, tt.wantBrand != "")
+			}
+		})
+	}
+}
+
+func TestCreditCardBrands(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"allowed", "4111111111111111", true},
+		{"also allowed", "5555555555554444", true},
+		{"not allowed", "378282246310005", false},
+		{"invalid", "4111111111111112", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "creditcard=visa mastercard") == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := verify.Var("4111111111111111", "creditcard=maestro"); err == nil {
+		t.Error("want an error for an unknown brand, got nil")
+	}
+}
@@ -0,0 +1,93 @@
+package formats
+
+import (
+	"strconv"
+	"strings"
+)
+
+// cronField describes the values allowed in a field of a cron expression.
+type cronField struct {
+	min, max int
+	// names are the names accepted in place of numbers, starting from min.
+	names []string
+	// any is set if ? may be used in place of *.
+	any bool
+}
+
+var (
+	cronSecond  = cronField{min: 0, max: 59}
+	cronMinute  = cronField{min: 0, max: 59}
+	cronHour    = cronField{min: 0, max: 23}
+	cronDay     = cronField{min: 1, max: 31, any: true}
+	cronMonth   = cronField{min: 1, max: 12, names: strings.Fields("JAN FEB MAR APR MAY JUN JUL AUG SEP OCT NOV DEC")}
+	cronWeekday = cronField{min: 0, max: 7, names: strings.Fields("SUN MON TUE WED THU FRI SAT"), any: true}
+)
+
+var cronDescriptors = setOf("@yearly @annually @monthly @weekly @daily @midnight @hourly")
+
+// Cron reports whether s is a cron expression of five fields, for the minute, hour, day of month, month, and day of
+// week, or of six fields with a leading field for the second. Each field is * or a comma separated list of values and
+// ranges such as 1-5, each of which may be followed by a step such as */15. Months and days of the week may be given
+// by their three letter English names, and ? may stand for * in the day fields. The descriptors @yearly, @annually,
+// @monthly, @weekly, @daily, @midnight, and @hourly are accepted as well.
+func Cron(s string) bool {
+	if cronDescriptors[s] {
+		return true
+	}
+	var fields []cronField
+	values := strings.Fields(s)
+	switch len(values) {
+	case 5:
+		fields = []cronField{cronMinute, cronHour, cronDay, cronMonth, cronWeekday}
+	case 6:
+		fields = []cronField{cronSecond, cronMinute, cronHour, cronDay, cronMonth, cronWeekday}
+	default:
+		return false
+	}
+	for i, v := range values {
+		if !fields[i].valid(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// valid reports whether s is a valid value for the field.
+func (f cronField) valid(s string) bool {
+	for _, item := range strings.Split(s, ",") {
+		if rng, step, ok := strings.Cut(item, "/"); ok {
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 || n > f.max {
+				return false
+			}
+			item = rng
+		}
+		if item == "*" || f.any && item == "?" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(item, "-")
+		a, ok := f.value(lo)
+		if !ok {
+			return false
+		}
+		if isRange {
+			if b, ok := f.value(hi); !ok || b < a {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// value parses s as a number or name in the range of the field.
+func (f cronField) value(s string) (int, bool) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, true
+		}
+	}
+	if s == "" || !every(s, isDigit) {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil && f.min <= n && n <= f.max
+}
@@ -0,0 +1,40 @@
+package formats_test
+
+import "testing"
+
+func TestCron(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"every minute", "* * * * *", true},
+		{"works", "30 4 1,15 * 5", true},
+		{"ranges and steps", "*/15 9-17 * 1-6/2 MON-FRI", true},
+		{"with seconds", "0 */5 * * * ?", true},
+		{"names", "0 0 * jan,Jul sun", true},
+		{"sunday as seven", "0 0 * * 7", true},
+		{"descriptor", "@daily", true},
+		{"extra spaces", " 0  0 * * * ", true},
+		{"too few fields", "* * * *", false},
+		{"too many fields", "* * * * * * *", false},
+		{"minute out of range", "60 * * * *", false},
+		{"day of month zero", "0 0 0 * *", false},
+		{"month out of range", "0 0 * 13 *", false},
+		{"reversed range", "0 17-9 * * *", false},
+		{"zero step", "*/0 * * * *", false},
+		{"question mark in minute", "? * * * *", false},
+		{"unknown name", "0 0 * * MOO", false},
+		{"negative", "-1 * * * *", false},
+		{"empty item", "1,,2 * * * *", false},
+		{"unknown descriptor", "@reboot", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := check(t, "cron", tt.input); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
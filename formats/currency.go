@@ -0,0 +1,29 @@
+package formats
+
+import "strings"
+
+// currencyCodes are the active alphabetic codes of ISO 4217, including funds and precious metals.
+var currencyCodes = setOf(`
+	AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV BRL BSD BTN BWP BYN BZD CAD CDF
+	CHE CHF CHW CLF CLP CNY COP COU CRC CUP CVE CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF
+	GTQ GYD HKD HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD KZT LAK LBP LKR LRD
+	LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP
+	PKR PLN PYG QAR RON RSD RUB RWF SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL THB TJS TMT TND
+	TOP TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF XAG XAU XBA XBB XBC XBD XCD XCG XDR
+	XOF XPD XPF XPT XSU XTS XUA XXX YER ZAR ZMW ZWG ZWL
+`)
+
+// Currency reports whether s is an active ISO 4217 currency code, such as USD or EUR. Codes must be upper case.
+func Currency(s string) bool {
+	return currencyCodes[s]
+}
+
+// setOf returns the set of the whitespace separated fields of s.
+func setOf(s string) map[string]bool {
+	fields := strings.Fields(s)
+	set := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		set[f] = true
+	}
+	return set
+}
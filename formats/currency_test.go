@@ -0,0 +1,31 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestCurrency(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"usd", "USD", true},
+		{"eur", "EUR", true},
+		{"gold", "XAU", true},
+		{"lower case", "usd", false},
+		{"unknown", "ABC", false},
+		{"withdrawn", "DEM", false},
+		{"too long", "USDD", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "currency") == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
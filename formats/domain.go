@@ -0,0 +1,27 @@
+package formats
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Domain reports whether s is a registrable domain, one label below a public suffix, such as example.com or
+// example.co.uk but not www.example.com or co.uk. The suffix must be on the public suffix list built into
+// golang.org/x/net/publicsuffix. Internationalized names may be given in Unicode, such as bücher.example, or in
+// punycode, such as xn--bcher-kva.example.
+func Domain(s string) bool {
+	ascii, err := idna.Lookup.ToASCII(s)
+	if err != nil || !Hostname(ascii) {
+		return false
+	}
+	suffix, icann := publicsuffix.PublicSuffix(ascii)
+	// A top-level label missing from the list is treated as a public suffix, but it is not one that can be registered
+	// under; every private suffix has at least two labels.
+	if !icann && !strings.Contains(suffix, ".") {
+		return false
+	}
+	etld1, err := publicsuffix.EffectiveTLDPlusOne(ascii)
+	return err == nil && etld1 == ascii
+}
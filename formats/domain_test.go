@@ -0,0 +1,34 @@
+package formats_test
+
+import "testing"
+
+func TestDomain(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"works", "example.com", true},
+		{"works multi-label suffix", "example.co.uk", true},
+		{"works private suffix", "gopher.github.io", true},
+		{"upper case", "Example.COM", true},
+		{"unicode", "bücher.de", true},
+		{"punycode", "xn--bcher-kva.de", true},
+		{"subdomain", "www.example.com", false},
+		{"public suffix", "co.uk", false},
+		{"top-level domain", "com", false},
+		{"unlisted top-level domain", "example.notatld", false},
+		{"invalid punycode", "xn--a.de", false},
+		{"trailing dot", "example.com.", false},
+		{"underscore", "ex_ample.com", false},
+		{"ip address", "192.0.2.1", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := check(t, "domain", tt.input); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package formats
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+const (
+	// maxEmailLength is the maximum length of a forward-path, less its angle brackets, from RFC 5321.
+	maxEmailLength = 254
+	// maxLocalLength is the maximum length of the local part of an address from RFC 5321.
+	maxLocalLength = 64
+)
+
+// email builds the email format. The value strict selects StrictEmail.
+func email(param string) (func(string) bool, error) {
+	switch param {
+	case "":
+		return Email, nil
+	case "strict":
+		return StrictEmail, nil
+	}
+	return nil, fmt.Errorf("email value %q must be strict or empty", param)
+}
+
+// Email reports whether s is a bare email address, such as gopher@example.com, as parsed by mail.ParseAddress. A
+// display name or angle brackets are not accepted.
+func Email(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Name == "" && addr.Address == s
+}
+
+// StrictEmail reports whether s is an email address in the same way as Email, but also applies the limits of RFC 5321:
+// the local part must be at most 64 characters, the domain must be a hostname with at least two labels,
+// and the address must be at most 254 characters.
+func StrictEmail(s string) bool {
+	if len(s) > maxEmailLength || !Email(s) {
+		return false
+	}
+	i := strings.LastIndexByte(s, '@')
+	local, domain := s[:i], s[i+1:]
+	if len(local) > maxLocalLength {
+		return false
+	}
+	return strings.Contains(domain, ".") && Hostname(domain)
+}
@@ -0,0 +1,47 @@
+package formats_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestEmail(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		want       bool
+		wantStrict bool
+	}{
+		{"works", "gopher@example.com", true, true},
+		{"works plus", "go.pher+tag@mail.example.co.uk", true, true},
+		{"empty", "", false, false},
+		{"no at", "gopher.example.com", false, false},
+		{"no local part", "@example.com", false, false},
+		{"display name", "Gopher <gopher@example.com>", false, false},
+		{"angle brackets", "<gopher@example.com>", false, false},
+		{"spaces", "go pher@example.com", false, false},
+		{"single label domain", "gopher@localhost", true, false},
+		{"quoted local part", `"go pher"@example.com`, false, false},
+		{"local part too long", strings.Repeat("a", 65) + "@example.com", true, false},
+		{"address too long", "a@" + strings.Repeat(strings.Repeat("b", 60)+".", 5) + "com", true, false},
+		{"label starts with hyphen", "gopher@-example.com", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "email") == nil; got != tt.want {
+				t.Errorf("email got %v, want %v", got, tt.want)
+			}
+			if got := verify.Var(tt.input, "email=strict") == nil; got != tt.wantStrict {
+				t.Errorf("email=strict got %v, want %v", got, tt.wantStrict)
+			}
+		})
+	}
+}
+
+func TestEmailConfig(t *testing.T) {
+	if err := verify.Var("gopher@example.com", "email=loose"); err == nil {
+		t.Error("want an error for an unknown value, got nil")
+	}
+}
@@ -0,0 +1,189 @@
+// Package formats registers common string formats with the verify package. Importing it, usually for its side effects
+// alone, allows the formats to be used by any Validator with a tag like `verify:"format=uuid"` or `verify:"uuid"`:
+//
+//	import _ "github.com/codyoss/verify/formats"
+//
+// The following formats are currently registered:
+//
+// uuid -- a UUID in its canonical form of 32 hexadecimal digits separated by hyphens into groups of 8-4-4-4-12, for
+// example 123e4567-e89b-12d3-a456-426614174000. Upper and lower case digits are accepted.
+//
+// email -- a bare email address, such as gopher@example.com, as parsed by net/mail. Display names and quoted local
+// parts are not accepted. With the value strict, for example `verify:"email=strict"`, the limits of RFC 5321 on the
+// length of the address and its local part are applied as well, and the domain must be a hostname of at least two
+// labels.
+//
+// url -- an absolute URL with both a scheme and a host, such as https://example.com/path. A space separated list of
+// the schemes allowed may be given, for example `verify:"url=https"` for a webhook endpoint.
+//
+// uri -- an absolute URI, which has a scheme but need not have a host, such as mailto:gopher@example.com. The schemes
+// allowed may be given in the same way as url.
+//
+// ip, ipv4, ipv6 -- an IP address of either version, only IPv4, or only IPv6, as parsed by net/netip, such as
+// 192.0.2.1 or 2001:db8::1.
+//
+// cidr -- an IP address prefix in CIDR notation, such as 192.0.2.0/24 or 2001:db8::/32.
+//
+// hostname -- a hostname as described by RFC 1123, such as example.com or localhost.
+//
+// fqdn -- a fully qualified domain name, which is a hostname of at least two labels, optionally ending in a dot, whose
+// top-level label is not entirely numeric.
+//
+// port -- a port number from 1 to 65535. This may be used on integer fields as well as strings.
+//
+// hostport -- a host and port separated by a colon, such as example.com:443, 192.0.2.1:8080, or [2001:db8::1]:443.
+// The host must be a hostname or an IP address, with an IPv6 address enclosed in square brackets.
+//
+// hex -- a string of hexadecimal digits of even length, so it encodes whole bytes, such as a token or digest. The
+// number of bytes may be given, for example `verify:"hex=32"` for a SHA-256 digest of 64 digits.
+//
+// hexcolor -- a color in hexadecimal notation, either #RGB or #RRGGBB.
+//
+// md5, sha1, sha256, sha512 -- a hex digest of the size produced by the named algorithm, such as a checksum or
+// content address.
+//
+// json -- syntactically valid JSON, for fields holding raw JSON as a string or []byte. The top-level kind may be
+// given as one of object, array, string, number, boolean, or null, for example `verify:"json=object"`.
+//
+// creditcard -- a payment card number of a known brand, based on its leading digits and length, that passes the Luhn
+// checksum. The digits may be separated by spaces or hyphens. A space separated list of the brands accepted may be
+// given from visa, mastercard, amex, discover, diners, jcb, and unionpay, for example
+// `verify:"creditcard=visa mastercard"`.
+//
+// currency -- an active ISO 4217 currency code in upper case, such as USD or EUR, checked against a table built into
+// the package.
+//
+// timezone -- the name of a location in the IANA Time Zone database, such as America/New_York, that
+// time.LoadLocation can load. Import time/tzdata to check names against a copy of the database embedded in the
+// program rather than the system's.
+//
+// e164 -- an international phone number in E.164 format, a plus sign followed by 8 to 15 digits with no separators,
+// such as +14155552671. A space separated list of the country calling codes allowed may be given, for example
+// `verify:"e164=1 44"`.
+//
+// jwt -- a structurally valid JWT in the JWS compact serialization, three base64url segments whose first decodes to a
+// JSON object header. The signature is not verified.
+//
+// alpha, alphanum, numeric -- a non-empty string of only ASCII letters, ASCII letters and digits, or the digits 0 to 9.
+// numeric does not accept signs or decimal points, so it suits codes that may have leading zeros.
+//
+// ascii, printascii -- a string of only ASCII characters, or only printable ASCII characters from space to tilde.
+//
+// utf8 -- a string or []byte of only valid UTF-8 encoded runes.
+//
+// nfc -- valid UTF-8 in Unicode Normalization Form C, so it compares and indexes the same as other NFC text.
+//
+// printable -- valid UTF-8 without control characters, including NUL, tabs, and line breaks. With the value strict,
+// for example `verify:"printable=strict"`, bidirectional formatting characters and zero-width characters are rejected
+// as well.
+//
+// isbn, isbn10, isbn13 -- an ISBN-10 or ISBN-13, only an ISBN-10, or only an ISBN-13, with a valid check digit. The
+// digits may be separated by hyphens or spaces, as in 978-0-306-40615-7.
+//
+// issn -- an ISSN with a valid check digit, written as two groups of four digits separated by a hyphen, such as
+// 0317-8471.
+//
+// ean, ean8, ean13 -- an EAN-8 or EAN-13 barcode number, only an EAN-8, or only an EAN-13, with a valid check digit.
+//
+// ulid -- a ULID, 26 characters of Crockford base32 such as 01ARZ3NDEKTSV4RRFFQ69G5FAV. Upper and lower case
+// characters are accepted.
+//
+// ksuid -- a KSUID, 27 base62 characters such as 0ujtsYcgvSTl8PAuAdqWYSMnLOv.
+//
+// nohtml -- text without HTML tags, comments, or character references such as &amp;, for fields like display names
+// that must not carry markup. A < or & that can not begin markup, as in "a < b" or "AT&T", is accepted.
+//
+// cron -- a cron expression of five fields, or six with a leading field for the second, such as */15 9-17 * * MON-FRI.
+// Fields may hold lists, ranges, steps, and the names of months and days of the week, and the descriptors such as
+// @daily are accepted as well.
+//
+// mime -- a media type as parsed by mime.ParseMediaType, such as text/html; charset=utf-8. A space separated list of
+// the media types allowed may be given, where a type such as image/* allows any subtype, for example
+// `verify:"mime=image/* application/pdf"`.
+//
+// domain -- a registrable domain, one label below a public suffix, such as example.com or example.co.uk but not
+// www.example.com. Suffixes are checked against the public suffix list built into golang.org/x/net/publicsuffix, and
+// internationalized names may be given in Unicode or punycode.
+//
+// mask -- a string matching a pattern character for character, where # matches a digit, A matches an ASCII letter, and
+// any other character matches only itself, for example `verify:"mask=###-##-####"`. A pattern containing a comma must
+// be quoted, as in `verify:"mask='#,###'"`.
+//
+// Each format is also exported as a function so it may be used directly. Other formats may be registered with
+// verify.RegisterFormat.
+package formats
+
+import "github.com/codyoss/verify"
+
+func init() {
+	verify.RegisterFormat("uuid", UUID)
+	verify.RegisterFormatFunc("email", email)
+	verify.RegisterFormatFunc("url", urlFormat(false))
+	verify.RegisterFormatFunc("uri", urlFormat(true))
+	verify.RegisterFormat("ip", IP)
+	verify.RegisterFormat("ipv4", IPv4)
+	verify.RegisterFormat("ipv6", IPv6)
+	verify.RegisterFormat("cidr", CIDR)
+	verify.RegisterFormat("hostname", Hostname)
+	verify.RegisterFormat("fqdn", FQDN)
+	verify.RegisterFormat("port", Port)
+	verify.RegisterFormat("hostport", HostPort)
+	verify.RegisterFormatFunc("hex", hexFormat)
+	verify.RegisterFormat("hexcolor", HexColor)
+	verify.RegisterFormat("md5", MD5)
+	verify.RegisterFormat("sha1", SHA1)
+	verify.RegisterFormat("sha256", SHA256)
+	verify.RegisterFormat("sha512", SHA512)
+	verify.RegisterFormatFunc("json", jsonFormat)
+	verify.RegisterFormatFunc("creditcard", creditCardFormat)
+	verify.RegisterFormat("currency", Currency)
+	verify.RegisterFormat("timezone", Timezone)
+	verify.RegisterFormatFunc("e164", e164Format)
+	verify.RegisterFormat("jwt", JWT)
+	verify.RegisterFormat("alpha", Alpha)
+	verify.RegisterFormat("alphanum", Alphanum)
+	verify.RegisterFormat("numeric", Numeric)
+	verify.RegisterFormat("ascii", ASCII)
+	verify.RegisterFormat("printascii", PrintASCII)
+	verify.RegisterFormat("utf8", UTF8)
+	verify.RegisterFormat("nfc", NFC)
+	verify.RegisterFormatFunc("printable", printableFormat)
+	verify.RegisterFormat("isbn", ISBN)
+	verify.RegisterFormat("isbn10", ISBN10)
+	verify.RegisterFormat("isbn13", ISBN13)
+	verify.RegisterFormat("issn", ISSN)
+	verify.RegisterFormat("ean", EAN)
+	verify.RegisterFormat("ean8", EAN8)
+	verify.RegisterFormat("ean13", EAN13)
+	verify.RegisterFormat("ulid", ULID)
+	verify.RegisterFormat("ksuid", KSUID)
+	verify.RegisterFormat("nohtml", NoHTML)
+	verify.RegisterFormat("cron", Cron)
+	verify.RegisterFormatFunc("mime", mimeFormat)
+	verify.RegisterFormat("domain", Domain)
+	verify.RegisterFormatFunc("mask", maskFormat)
+}
+
+// UUID reports whether s is a UUID in its canonical form.
+func UUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHex(s[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
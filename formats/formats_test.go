@@ -0,0 +1,67 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+	_ "github.com/codyoss/verify/formats"
+)
+
+// check verifies s against the format name used as a sub-tag of its own, reporting whether it passed.
+func check(t *testing.T, name, s string) bool {
+	t.Helper()
+	return verify.Var(s, name) == nil
+}
+
+func TestUUID(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"lower case", "123e4567-e89b-12d3-a456-426614174000", true},
+		{"upper case", "123E4567-E89B-12D3-A456-426614174000", true},
+		{"empty", "", false},
+		{"no hyphens", "123e4567e89b12d3a456426614174000", false},
+		{"hyphen misplaced", "123e456-7e89b-12d3-a456-426614174000", false},
+		{"not hex", "123e4567-e89b-12d3-a456-42661417400g", false},
+		{"too long", "123e4567-e89b-12d3-a456-4266141740000", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := check(t, "uuid", tt.input); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTag(t *testing.T) {
+	type A struct {
+		ID   string   `verify:"format=uuid"`
+		Ref  *string  `verify:"omitempty,uuid"`
+		Raw  []byte   `verify:"uuid"`
+		Tags []string `verify:"dive,uuid"`
+	}
+	id := "123e4567-e89b-12d3-a456-426614174000"
+
+	tests := []struct {
+		name    string
+		input   A
+		wantErr bool
+	}{
+		{"works", A{ID: id, Ref: &id, Raw: []byte(id), Tags: []string{id}}, false},
+		{"format fails", A{ID: "a", Raw: []byte(id)}, true},
+		{"pointer fails", A{ID: id, Ref: new(string), Raw: []byte(id)}, true},
+		{"bytes fail", A{ID: id, Raw: []byte("a")}, true},
+		{"dive fails", A{ID: id, Raw: []byte(id), Tags: []string{"a"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package formats
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// hexFormat builds the hex format. param is the number of bytes the string must encode, or empty to allow any.
+func hexFormat(param string) (func(string) bool, error) {
+	if param == "" {
+		return Hex, nil
+	}
+	n, err := strconv.Atoi(param)
+	if err != nil || n <= 0 {
+		return nil, fmt.Errorf("hex value %q must be a positive number of bytes", param)
+	}
+	return func(s string) bool { return len(s) == 2*n && Hex(s) }, nil
+}
+
+// Hex reports whether s is a non-empty string of hexadecimal digits of even length, so it encodes whole bytes. Upper
+// and lower case digits are accepted.
+func Hex(s string) bool {
+	if len(s) == 0 || len(s)%2 != 0 {
+		return false
+	}
+	return every(s, isHex)
+}
+
+// HexColor reports whether s is a color in hexadecimal notation, either #RGB or #RRGGBB.
+func HexColor(s string) bool {
+	if len(s) != 4 && len(s) != 7 || s[0] != '#' {
+		return false
+	}
+	return every(s[1:], isHex)
+}
+
+// The byte lengths of the digests below are written out, rather than taken from the crypto packages, so the hash
+// implementations are not linked into every binary using formats.
+
+// MD5 reports whether s is a hex MD5 digest of 32 digits.
+func MD5(s string) bool { return len(s) == 2*16 && Hex(s) }
+
+// SHA1 reports whether s is a hex SHA-1 digest of 40 digits.
+func SHA1(s string) bool { return len(s) == 2*20 && Hex(s) }
+
+// SHA256 reports whether s is a hex SHA-256 digest of 64 digits.
+func SHA256(s string) bool { return len(s) == 2*32 && Hex(s) }
+
+// SHA512 reports whether s is a hex SHA-512 digest of 128 digits.
+func SHA512(s string) bool { return len(s) == 2*64 && Hex(s) }
@@ -0,0 +1,65 @@
+package formats_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestHex(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		rules string
+		want  bool
+	}{
+		{"works", "deadBEEF", "hex", true},
+		{"odd length", "abc", "hex", false},
+		{"empty", "", "hex", false},
+		{"not hex", "0x12", "hex", false},
+		{"byte length", "00112233", "hex=4", true},
+		{"wrong byte length", "001122", "hex=4", false},
+		{"color short", "#fA0", "hexcolor", true},
+		{"color long", "#ff00AA", "hexcolor", true},
+		{"color missing hash", "ff00aa", "hexcolor", false},
+		{"color wrong length", "#ff00a", "hexcolor", false},
+		{"color not hex", "#ggg", "hexcolor", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, tt.rules) == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDigests(t *testing.T) {
+	digests := map[string]string{
+		"md5":    "d41d8cd98f00b204e9800998ecf8427e",
+		"sha1":   "da39a3ee5e6b4b0d3255bfef95601890afd80709",
+		"sha256": "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		"sha512": "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce" +
+			"47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e",
+	}
+	for rules := range digests {
+		for algorithm, digest := range digests {
+			want := algorithm == rules
+			if got := verify.Var(digest, rules) == nil; got != want {
+				t.Errorf("%s of a %s digest got %v, want %v", rules, algorithm, got, want)
+			}
+		}
+		if err := verify.Var(strings.Repeat("z", len(digests[rules])), rules); err == nil {
+			t.Errorf("%s of a non-hex string want an error, got nil", rules)
+		}
+	}
+}
+
+func TestHexConfig(t *testing.T) {
+	for _, rules := range []string{"hex=abc", "hex=0", "hexcolor=1"} {
+		if err := verify.Var("00", rules); err == nil {
+			t.Errorf("Var with %q want an error, got nil", rules)
+		}
+	}
+}
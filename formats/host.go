@@ -0,0 +1,70 @@
+package formats
+
+import (
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxHostnameLength and maxLabelLength are the maximum lengths of a hostname and each of its labels.
+	maxHostnameLength = 253
+	maxLabelLength    = 63
+)
+
+// Hostname reports whether s is a hostname as described by RFC 1123: labels of letters, digits, and hyphens
+// separated by dots, where each label is 1 to 63 characters and does not start or end with a hyphen.
+func Hostname(s string) bool {
+	if len(s) == 0 || len(s) > maxHostnameLength {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if len(label) == 0 || len(label) > maxLabelLength || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// FQDN reports whether s is a fully qualified domain name: a Hostname of at least two labels, optionally ending in a
+// dot, whose top-level label is not entirely numeric.
+func FQDN(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	i := strings.LastIndexByte(s, '.')
+	if i == -1 || !Hostname(s) {
+		return false
+	}
+	_, err := strconv.Atoi(s[i+1:])
+	return err != nil
+}
+
+// Port reports whether s is a port number from 1 to 65535, without a sign or leading zeros.
+func Port(s string) bool {
+	if len(s) > 1 && s[0] == '0' {
+		return false
+	}
+	p, err := strconv.ParseUint(s, 10, 16)
+	return err == nil && p != 0
+}
+
+// HostPort reports whether s is a host and port separated by a colon, such as example.com:443 or [2001:db8::1]:443.
+// The host must be a Hostname or an IP address, with an IPv6 address enclosed in square brackets, and the port must
+// satisfy Port.
+func HostPort(s string) bool {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil || !Port(port) {
+		return false
+	}
+	if _, err := netip.ParseAddr(host); err == nil {
+		// SplitHostPort removes the brackets from an IPv6 address but does not require them for IPv4.
+		return strings.Contains(host, ":") == strings.HasPrefix(s, "[")
+	}
+	return Hostname(host)
+}
@@ -0,0 +1,97 @@
+package formats_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestHostname(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantHostname bool
+		wantFQDN     bool
+	}{
+		{"works", "example.com", true, true},
+		{"works subdomain", "api.eu-west-1.example.com", true, true},
+		{"single label", "localhost", true, false},
+		{"trailing dot", "example.com.", false, true},
+		{"numeric top-level label", "192.0.2.1", true, false},
+		{"empty", "", false, false},
+		{"empty label", "example..com", false, false},
+		{"leading hyphen", "-example.com", false, false},
+		{"trailing hyphen", "example-.com", false, false},
+		{"underscore", "ex_ample.com", false, false},
+		{"label too long", strings.Repeat("a", 64) + ".com", false, false},
+		{"too long", strings.Repeat(strings.Repeat("a", 63)+".", 4) + "com", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "hostname") == nil; got != tt.wantHostname {
+				t.Errorf("hostname got %v, want %v", got, tt.wantHostname)
+			}
+			if got := verify.Var(tt.input, "fqdn") == nil; got != tt.wantFQDN {
+				t.Errorf("fqdn got %v, want %v", got, tt.wantFQDN)
+			}
+		})
+	}
+}
+
+func TestPort(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		want  bool
+	}{
+		{"works", "8080", true},
+		{"works max", "65535", true},
+		{"works int", 443, true},
+		{"works uint16", uint16(1), true},
+		{"zero", "0", false},
+		{"zero int", 0, false},
+		{"too large", "65536", false},
+		{"too large int", 70000, false},
+		{"negative int", -1, false},
+		{"leading zero", "080", false},
+		{"sign", "+80", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "port") == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostPort(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"works hostname", "example.com:443", true},
+		{"works single label", "localhost:8080", true},
+		{"works ipv4", "192.0.2.1:8080", true},
+		{"works ipv6", "[2001:db8::1]:443", true},
+		{"ipv6 without brackets", "2001:db8::1:443", false},
+		{"ipv4 in brackets", "[192.0.2.1]:443", false},
+		{"no port", "example.com", false},
+		{"empty port", "example.com:", false},
+		{"port out of range", "example.com:65536", false},
+		{"named port", "example.com:https", false},
+		{"empty host", ":443", false},
+		{"invalid hostname", "ex_ample.com:443", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "hostport") == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
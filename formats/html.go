@@ -0,0 +1,39 @@
+package formats
+
+import "strings"
+
+// NoHTML reports whether s contains no HTML markup: no tags, comments, or declarations, which begin with < followed by
+// a letter, /, !, or ?, and no character references such as &amp; or &#60;. A < or & that can not begin markup, as in
+// "a < b" or "AT&T", is accepted.
+func NoHTML(s string) bool {
+	for i := 0; i < len(s)-1; i++ {
+		switch s[i] {
+		case '<':
+			if c := s[i+1]; isAlpha(c) || c == '/' || c == '!' || c == '?' {
+				return false
+			}
+		case '&':
+			if isCharRef(s[i+1:]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isCharRef reports whether s begins with the remainder of a character reference after its &, either a name or a
+// decimal or hexadecimal number, followed by a semicolon.
+func isCharRef(s string) bool {
+	end := strings.IndexByte(s, ';')
+	if end <= 0 {
+		return false
+	}
+	ref := s[:end]
+	switch {
+	case ref[0] != '#':
+		return every(ref, func(c byte) bool { return isAlpha(c) || isDigit(c) })
+	case len(ref) > 2 && (ref[1] == 'x' || ref[1] == 'X'):
+		return every(ref[2:], isHex)
+	}
+	return len(ref) > 1 && every(ref[1:], isDigit)
+}
@@ -0,0 +1,33 @@
+package formats_test
+
+import "testing"
+
+func TestNoHTML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"works", "Gopher McGo", true},
+		{"empty", "", true},
+		{"less than", "a < b", true},
+		{"ampersand", "AT&T", true},
+		{"ampersand with semicolon later", "Tom & Jerry; Friends", true},
+		{"trailing less than", "a <", true},
+		{"tag", "<b>Gopher</b>", false},
+		{"script", "Gopher<script>alert(1)</script>", false},
+		{"closing tag", "Gopher</div>", false},
+		{"comment", "Go<!-- -->pher", false},
+		{"processing instruction", "<?xml version=\"1.0\"?>", false},
+		{"named entity", "Gopher&nbsp;McGo", false},
+		{"decimal entity", "&#60;b&#62;", false},
+		{"hex entity", "&#x3C;b&#x3E;", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := check(t, "nohtml", tt.input); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package formats
+
+import "strings"
+
+// crockford is the Crockford base32 alphabet used by ULIDs, which omits I, L, O, and U.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// maxKSUID is the largest KSUID, encoding 20 bytes of ones.
+const maxKSUID = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// ULID reports whether s is a ULID, 26 characters of Crockford base32 encoding a 128-bit value, such as
+// 01ARZ3NDEKTSV4RRFFQ69G5FAV. Upper and lower case characters are accepted.
+func ULID(s string) bool {
+	// 26 characters hold 130 bits, so the first may not exceed 7.
+	if len(s) != 26 || s[0] > '7' {
+		return false
+	}
+	return every(strings.ToUpper(s), func(c byte) bool { return strings.IndexByte(crockford, c) >= 0 })
+}
+
+// KSUID reports whether s is a KSUID, 27 base62 characters encoding a 160-bit value, such as
+// 0ujtsYcgvSTl8PAuAdqWYSMnLOv.
+func KSUID(s string) bool {
+	if len(s) != 27 || !every(s, func(c byte) bool { return isAlpha(c) || isDigit(c) }) {
+		return false
+	}
+	// The base62 alphabet is in byte order, so a fixed width encoding compares the same as its value.
+	return s <= maxKSUID
+}
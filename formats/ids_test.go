@@ -0,0 +1,50 @@
+package formats_test
+
+import "testing"
+
+func TestULID(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"works", "01ARZ3NDEKTSV4RRFFQ69G5FAV", true},
+		{"lower case", "01arz3ndektsv4rrffq69g5fav", true},
+		{"largest", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", true},
+		{"overflows", "8ZZZZZZZZZZZZZZZZZZZZZZZZZ", false},
+		{"excluded letter", "01ARZ3NDEKTSV4RRFFQ69G5FAU", false},
+		{"too short", "01ARZ3NDEKTSV4RRFFQ69G5FA", false},
+		{"too long", "01ARZ3NDEKTSV4RRFFQ69G5FAVV", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := check(t, "ulid", tt.input); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKSUID(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"works", "0ujtsYcgvSTl8PAuAdqWYSMnLOv", true},
+		{"smallest", "000000000000000000000000000", true},
+		{"largest", "aWgEPTl1tmebfsQzFP4bxwgy80V", true},
+		{"overflows", "aWgEPTl1tmebfsQzFP4bxwgy80W", false},
+		{"not base62", "0ujtsYcgvSTl8PAuAdqWYSMnLO-", false},
+		{"too short", "0ujtsYcgvSTl8PAuAdqWYSMnLO", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := check(t, "ksuid", tt.input); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package formats
+
+import "net/netip"
+
+// IP reports whether s is an IPv4 or IPv6 address, such as 192.0.2.1 or 2001:db8::1.
+func IP(s string) bool {
+	_, err := netip.ParseAddr(s)
+	return err == nil
+}
+
+// IPv4 reports whether s is an IPv4 address in dotted decimal form, such as 192.0.2.1.
+func IPv4(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Is4()
+}
+
+// IPv6 reports whether s is an IPv6 address, such as 2001:db8::1. IPv4-mapped addresses like ::ffff:192.0.2.1 are
+// IPv6 addresses.
+func IPv6(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Is6()
+}
+
+// CIDR reports whether s is an IP address prefix in CIDR notation, such as 192.0.2.0/24 or 2001:db8::/32.
+func CIDR(s string) bool {
+	_, err := netip.ParsePrefix(s)
+	return err == nil
+}
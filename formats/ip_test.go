@@ -0,0 +1,37 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestIP(t *testing.T) {
+	tests := []struct {
+		name                 string
+		input                string
+		wantIP, want4, want6 bool
+		wantCIDR             bool
+	}{
+		{"ipv4", "192.0.2.1", true, true, false, false},
+		{"ipv6", "2001:db8::1", true, false, true, false},
+		{"ipv4-mapped ipv6", "::ffff:192.0.2.1", true, false, true, false},
+		{"ipv6 zone", "fe80::1%eth0", true, false, true, false},
+		{"ipv4 prefix", "192.0.2.0/24", false, false, false, true},
+		{"ipv6 prefix", "2001:db8::/32", false, false, false, true},
+		{"empty", "", false, false, false, false},
+		{"hostname", "example.com", false, false, false, false},
+		{"ipv4 out of range", "256.0.0.1", false, false, false, false},
+		{"ipv4 leading zero", "192.0.2.01", false, false, false, false},
+		{"prefix too long", "192.0.2.0/33", false, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for rules, want := range map[string]bool{"ip": tt.wantIP, "ipv4": tt.want4, "ipv6": tt.want6, "cidr": tt.wantCIDR} {
+				if got := verify.Var(tt.input, rules) == nil; got != want {
+					t.Errorf("%s got %v, want %v", rules, got, want)
+				}
+			}
+		})
+	}
+}
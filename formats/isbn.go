@@ -0,0 +1,85 @@
+package formats
+
+import "strings"
+
+// stripSeparators removes the hyphens and spaces that commonly group the digits of a catalog code.
+var stripSeparators = strings.NewReplacer("-", "", " ", "").Replace
+
+// ISBN reports whether s is an ISBN-10 or ISBN-13 with a valid check digit. The digits may be separated by hyphens or
+// spaces.
+func ISBN(s string) bool {
+	return ISBN10(s) || ISBN13(s)
+}
+
+// ISBN10 reports whether s is an ISBN-10 with a valid check digit, which may be X. The digits may be separated by
+// hyphens or spaces.
+func ISBN10(s string) bool {
+	return mod11(stripSeparators(s), 10)
+}
+
+// ISBN13 reports whether s is an ISBN-13, an EAN-13 beginning with 978 or 979, with a valid check digit. The digits may
+// be separated by hyphens or spaces.
+func ISBN13(s string) bool {
+	digits := stripSeparators(s)
+	return (strings.HasPrefix(digits, "978") || strings.HasPrefix(digits, "979")) && ean(digits, 13)
+}
+
+// ISSN reports whether s is an ISSN of two groups of four digits separated by a hyphen, such as 0317-8471, with a valid
+// check digit, which may be X.
+func ISSN(s string) bool {
+	return len(s) == 9 && s[4] == '-' && mod11(s[:4]+s[5:], 8)
+}
+
+// EAN reports whether s is an EAN-8 or EAN-13 barcode number with a valid check digit.
+func EAN(s string) bool {
+	return EAN8(s) || EAN13(s)
+}
+
+// EAN8 reports whether s is an EAN-8 barcode number of 8 digits with a valid check digit.
+func EAN8(s string) bool {
+	return ean(s, 8)
+}
+
+// EAN13 reports whether s is an EAN-13 barcode number of 13 digits with a valid check digit.
+func EAN13(s string) bool {
+	return ean(s, 13)
+}
+
+// mod11 reports whether s is n digits whose last is a modulus 11 check digit, with X standing for 10, as used by
+// ISBN-10 and ISSN.
+func mod11(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	sum := 0
+	for i := 0; i < n; i++ {
+		c := s[i]
+		d := int(c - '0')
+		switch {
+		case isDigit(c):
+		case i == n-1 && (c == 'X' || c == 'x'):
+			d = 10
+		default:
+			return false
+		}
+		sum += (n - i) * d
+	}
+	return sum%11 == 0
+}
+
+// ean reports whether s is n digits whose last is a GS1 check digit, weighting the digits alternately by 3 and 1 from
+// the right.
+func ean(s string, n int) bool {
+	if len(s) != n || !every(s, isDigit) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < n; i++ {
+		d := int(s[n-1-i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
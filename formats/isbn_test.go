@@ -0,0 +1,39 @@
+package formats_test
+
+import "testing"
+
+func TestCatalogCodes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		rules map[string]bool
+	}{
+		{"isbn-10", "0-306-40615-2", map[string]bool{"isbn": true, "isbn10": true, "isbn13": false}},
+		{"isbn-10 check digit x", "080442957X", map[string]bool{"isbn": true, "isbn10": true}},
+		{"isbn-10 bad check digit", "0-306-40615-3", map[string]bool{"isbn": false, "isbn10": false}},
+		{"isbn-10 x not last", "08044295X7", map[string]bool{"isbn10": false}},
+		{"isbn-13", "978-0-306-40615-7", map[string]bool{"isbn": true, "isbn10": false, "isbn13": true}},
+		{"isbn-13 spaces", "978 0 306 40615 7", map[string]bool{"isbn13": true}},
+		{"isbn-13 bad check digit", "9780306406158", map[string]bool{"isbn": false, "isbn13": false}},
+		{"isbn-13 not bookland", "4006381333931", map[string]bool{"isbn13": false, "ean13": true}},
+		{"issn", "0317-8471", map[string]bool{"issn": true}},
+		{"issn check digit x", "1050-124X", map[string]bool{"issn": true}},
+		{"issn bad check digit", "0317-8472", map[string]bool{"issn": false}},
+		{"issn no hyphen", "03178471", map[string]bool{"issn": false}},
+		{"ean-13", "4006381333931", map[string]bool{"ean": true, "ean8": false, "ean13": true}},
+		{"ean-13 bad check digit", "4006381333932", map[string]bool{"ean": false, "ean13": false}},
+		{"ean-8", "73513537", map[string]bool{"ean": true, "ean8": true, "ean13": false}},
+		{"ean-8 bad check digit", "73513538", map[string]bool{"ean": false, "ean8": false}},
+		{"ean hyphens", "400-6381-333931", map[string]bool{"ean": false}},
+		{"empty", "", map[string]bool{"isbn": false, "issn": false, "ean": false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for rule, want := range tt.rules {
+				if got := check(t, rule, tt.input); got != want {
+					t.Errorf("%s got %v, want %v", rule, got, want)
+				}
+			}
+		})
+	}
+}
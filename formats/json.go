@@ -0,0 +1,38 @@
+package formats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonKinds maps each top-level kind that may be required by the json format to the bytes a value of that kind may
+// start with.
+var jsonKinds = map[string]string{
+	"object":  "{",
+	"array":   "[",
+	"string":  `"`,
+	"number":  "-0123456789",
+	"boolean": "tf",
+	"null":    "n",
+}
+
+// jsonFormat builds the json format. param is the top-level kind the value must be, or empty to allow any.
+func jsonFormat(param string) (func(string) bool, error) {
+	if param == "" {
+		return JSON, nil
+	}
+	starts, ok := jsonKinds[param]
+	if !ok {
+		return nil, fmt.Errorf("json value %q must be one of object, array, string, number, boolean, or null", param)
+	}
+	return func(s string) bool {
+		b := bytes.TrimLeft([]byte(s), " \t\r\n")
+		return JSON(s) && bytes.IndexByte([]byte(starts), b[0]) != -1
+	}, nil
+}
+
+// JSON reports whether s is syntactically valid JSON.
+func JSON(s string) bool {
+	return json.Valid([]byte(s))
+}
@@ -0,0 +1,47 @@
+package formats_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		rules string
+		want  bool
+	}{
+		{"works object", `{"a": [1, 2]}`, "json", true},
+		{"works scalar", `1.5`, "json", true},
+		{"works bytes", []byte(`[]`), "json", true},
+		{"works raw message", json.RawMessage(`null`), "json", true},
+		{"invalid", `{"a": }`, "json", false},
+		{"empty", ``, "json", false},
+		{"trailing data", `{} {}`, "json", false},
+		{"object", ` {"a": 1}`, "json=object", true},
+		{"not object", `[1]`, "json=object", false},
+		{"invalid object", `{`, "json=object", false},
+		{"array", `[1]`, "json=array", true},
+		{"string", `"a"`, "json=string", true},
+		{"number", `-1`, "json=number", true},
+		{"boolean", `false`, "json=boolean", true},
+		{"null", `null`, "json=null", true},
+		{"not null", `true`, "json=null", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, tt.rules) == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONConfig(t *testing.T) {
+	if err := verify.Var("{}", "json=map"); err == nil {
+		t.Error("want an error for an unknown kind, got nil")
+	}
+}
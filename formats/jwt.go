@@ -0,0 +1,31 @@
+package formats
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// JWT reports whether s is a structurally valid JWT in the JWS compact serialization: three base64url segments
+// separated by dots, the first of which decodes to a JSON object header. The signature is not verified, and may be
+// empty for an unsecured JWT.
+func JWT(s string) bool {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return false
+	}
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(header, &fields) != nil || fields == nil {
+		return false
+	}
+	for _, p := range parts[1:] {
+		if _, err := base64.RawURLEncoding.DecodeString(p); err != nil {
+			return false
+		}
+	}
+	return true
+}
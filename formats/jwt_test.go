@@ -0,0 +1,38 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestJWT(t *testing.T) {
+	const (
+		header    = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"
+		payload   = "eyJzdWIiOiIxMjM0NTY3ODkwIiwibmFtZSI6IkpvaG4gRG9lIiwiaWF0IjoxNTE2MjM5MDIyfQ"
+		signature = "SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c"
+	)
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"works", header + "." + payload + "." + signature, true},
+		{"works unsecured", "eyJhbGciOiJub25lIn0." + payload + ".", true},
+		{"two segments", header + "." + payload, false},
+		{"four segments", header + "." + payload + "." + signature + ".", false},
+		{"empty payload", header + ".." + signature, false},
+		{"padded", header + "." + payload + "." + signature + "=", false},
+		{"header not json", "bm90IGpzb24." + payload + "." + signature, false},
+		{"header not object", "WzFd." + payload + "." + signature, false},
+		{"not base64url", header + ".pay+load." + signature, false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "jwt") == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package formats
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// maskFormat builds the mask format. param is the pattern, which must not be empty.
+func maskFormat(param string) (func(string) bool, error) {
+	if param == "" {
+		return nil, errors.New("mask must specify a pattern")
+	}
+	return func(s string) bool { return Mask(param, s) }, nil
+}
+
+// Mask reports whether s matches pattern character for character, where # in pattern matches a digit from 0 to 9, A
+// matches an ASCII letter, and any other character matches only itself. For example, ###-##-#### matches 123-45-6789.
+func Mask(pattern, s string) bool {
+	if utf8.RuneCountInString(pattern) != utf8.RuneCountInString(s) {
+		return false
+	}
+	i := 0
+	for _, p := range pattern {
+		c, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		switch p {
+		case '#':
+			if c >= utf8.RuneSelf || !isDigit(byte(c)) {
+				return false
+			}
+		case 'A':
+			if c >= utf8.RuneSelf || !isAlpha(byte(c)) {
+				return false
+			}
+		default:
+			if c != p {
+				return false
+			}
+		}
+	}
+	return true
+}
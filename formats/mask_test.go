@@ -0,0 +1,43 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		rules string
+		want  bool
+	}{
+		{"works", "123-45-6789", "mask=###-##-####", true},
+		{"wrong separator", "123 45 6789", "mask=###-##-####", false},
+		{"letter for digit", "12a-45-6789", "mask=###-##-####", false},
+		{"too short", "123-45-678", "mask=###-##-####", false},
+		{"too long", "123-45-67890", "mask=###-##-####", false},
+		{"works letters", "AB12 CDE", "mask=AA## AAA", true},
+		{"lower case letters", "ab12 cde", "mask=AA## AAA", true},
+		{"digit for letter", "A112 CDE", "mask=AA## AAA", false},
+		{"non-ASCII letter", "ÄB12 CDE", "mask=AA## AAA", false},
+		{"non-ASCII literal", "12€", "mask=##€", true},
+		{"wrong non-ASCII literal", "12$", "mask=##€", false},
+		{"quoted comma", "1,000", "mask='#,###'", true},
+		{"empty", "", "mask=###", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, tt.rules) == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaskEmptyPattern(t *testing.T) {
+	if err := verify.Var("123", "mask="); err == nil {
+		t.Error("expected an error for an empty pattern")
+	}
+}
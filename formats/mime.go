@@ -0,0 +1,53 @@
+package formats
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// mimeFormat builds the mime format. param is a space separated list of the media types allowed, or empty to allow
+// any. A type such as image/* allows every subtype of image.
+func mimeFormat(param string) (func(string) bool, error) {
+	types := strings.Fields(param)
+	if len(types) == 0 {
+		return MIME, nil
+	}
+	allowed := make(map[string]bool, len(types))
+	for _, t := range types {
+		mediaType, _, err := mime.ParseMediaType(t)
+		if err != nil || !strings.Contains(mediaType, "/") {
+			return nil, fmt.Errorf("mime value %q is not a media type", t)
+		}
+		allowed[mediaType] = true
+	}
+	return func(s string) bool {
+		mediaType, ok := parseMediaType(s)
+		if !ok {
+			return false
+		}
+		top, _, _ := strings.Cut(mediaType, "/")
+		return allowed[mediaType] || allowed[top+"/*"]
+	}, nil
+}
+
+// MIME reports whether s is a media type of a type and subtype, optionally followed by parameters, such as text/plain
+// or text/html; charset=utf-8, as parsed by mime.ParseMediaType.
+func MIME(s string) bool {
+	_, ok := parseMediaType(s)
+	return ok
+}
+
+// parseMediaType returns the media type of s without its parameters, in lower case.
+func parseMediaType(s string) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(s)
+	if err != nil {
+		return "", false
+	}
+	// ParseMediaType accepts a type without a subtype, which is not a valid media type.
+	top, sub, ok := strings.Cut(mediaType, "/")
+	if !ok || top == "" || sub == "" || top == "*" || sub == "*" {
+		return "", false
+	}
+	return mediaType, true
+}
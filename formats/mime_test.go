@@ -0,0 +1,46 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestMIME(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		rules string
+		want  bool
+	}{
+		{"works", "text/plain", "mime", true},
+		{"parameters", "text/html; charset=utf-8", "mime", true},
+		{"vendor subtype", "application/vnd.api+json", "mime", true},
+		{"upper case", "Image/PNG", "mime", true},
+		{"no subtype", "text", "mime", false},
+		{"empty subtype", "text/", "mime", false},
+		{"wildcard", "image/*", "mime", false},
+		{"bad parameter", "text/plain; charset", "mime", false},
+		{"empty", "", "mime", false},
+		{"works set", "image/png", "mime=image/png image/jpeg", true},
+		{"works set with parameters", "image/jpeg; q=0.9", "mime=image/png image/jpeg", true},
+		{"works set upper case", "IMAGE/PNG", "mime=image/png image/jpeg", true},
+		{"not in set", "image/gif", "mime=image/png image/jpeg", false},
+		{"works set wildcard", "image/webp", "mime=image/* application/pdf", true},
+		{"not in set wildcard", "video/mp4", "mime=image/* application/pdf", false},
+		{"invalid in set", "image", "mime=image/*", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, tt.rules) == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMIMEInvalidSet(t *testing.T) {
+	if err := verify.Var("image/png", "mime=image"); err == nil {
+		t.Error("expected an error for a set entry without a subtype")
+	}
+}
@@ -0,0 +1,45 @@
+package formats
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	minE164Digits = 8
+	maxE164Digits = 15
+)
+
+// e164Format builds the e164 format. param is a space separated list of the country calling codes allowed, such as
+// 1 44, or empty to allow any.
+func e164Format(param string) (func(string) bool, error) {
+	codes := strings.Fields(param)
+	if len(codes) == 0 {
+		return E164, nil
+	}
+	for _, c := range codes {
+		if len(c) > 3 || c[0] == '0' || !every(c, isDigit) {
+			return nil, fmt.Errorf("e164 country calling code %q must be 1 to 3 digits not starting with 0", c)
+		}
+	}
+	return func(s string) bool {
+		if !E164(s) {
+			return false
+		}
+		for _, c := range codes {
+			if strings.HasPrefix(s[1:], c) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// E164 reports whether s is an international phone number in E.164 format: a plus sign followed by 8 to 15 digits,
+// the first of which is not 0, with no spaces or other separators, such as +14155552671.
+func E164(s string) bool {
+	if len(s) < 1+minE164Digits || len(s) > 1+maxE164Digits || s[0] != '+' || s[1] == '0' {
+		return false
+	}
+	return every(s[1:], isDigit)
+}
@@ -0,0 +1,44 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestE164(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		rules string
+		want  bool
+	}{
+		{"works", "+14155552671", "e164", true},
+		{"works shortest", "+49301234", "e164", true},
+		{"works longest", "+123456789012345", "e164", true},
+		{"too short", "+4930123", "e164", false},
+		{"too long", "+1234567890123456", "e164", false},
+		{"missing plus", "14155552671", "e164", false},
+		{"leading zero", "+04155552671", "e164", false},
+		{"separators", "+1 415 555 2671", "e164", false},
+		{"empty", "", "e164", false},
+		{"country allowed", "+442071838750", "e164=1 44", true},
+		{"country not allowed", "+33142685300", "e164=1 44", false},
+		{"invalid with country", "+44207", "e164=44", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, tt.rules) == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestE164Config(t *testing.T) {
+	for _, rules := range []string{"e164=+1", "e164=0", "e164=1234"} {
+		if err := verify.Var("+14155552671", rules); err == nil {
+			t.Errorf("Var with %q want an error, got nil", rules)
+		}
+	}
+}
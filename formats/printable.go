@@ -0,0 +1,54 @@
+package formats
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// printableFormat builds the printable format. The value strict selects StrictPrintable.
+func printableFormat(param string) (func(string) bool, error) {
+	switch param {
+	case "":
+		return Printable, nil
+	case "strict":
+		return StrictPrintable, nil
+	}
+	return nil, fmt.Errorf("printable value %q must be strict or empty", param)
+}
+
+// Printable reports whether s is valid UTF-8 without control characters, including NUL, tabs, and line breaks, which
+// could be used to inject forged lines into logs. The replacement character U+FFFD is printable.
+func Printable(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// StrictPrintable reports whether s is printable in the same way as Printable, and also contains no bidirectional
+// formatting characters or zero-width characters, which could be used to disguise text.
+func StrictPrintable(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) || unicode.Is(unicode.Bidi_Control, r) || isZeroWidth(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isZeroWidth(r rune) bool {
+	switch r {
+	case '\u200B', '\u200C', '\u200D', '\u2060', '\uFEFF':
+		return true
+	}
+	return false
+}
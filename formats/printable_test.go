@@ -0,0 +1,40 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestPrintable(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		want       bool
+		wantStrict bool
+	}{
+		{"works", "Gopher Café 日本", true, true},
+		{"empty", "", true, true},
+		{"nul", "go\x00pher", false, false},
+		{"newline", "gopher\nINFO forged", false, false},
+		{"tab", "go\tpher", false, false},
+		{"delete", "go\x7fpher", false, false},
+		{"c1 control", "go\u0085pher", false, false},
+		{"invalid utf8", "go\xffpher", false, false},
+		{"replacement character", "go\uFFFDpher", true, true},
+		{"bidi override", "gopher\u202Egnp.exe", true, false},
+		{"bidi isolate", "\u2066gopher\u2069", true, false},
+		{"zero-width space", "go\u200Bpher", true, false},
+		{"byte order mark", "\uFEFFgopher", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "printable") == nil; got != tt.want {
+				t.Errorf("printable got %v, want %v", got, tt.want)
+			}
+			if got := verify.Var(tt.input, "printable=strict") == nil; got != tt.wantStrict {
+				t.Errorf("printable=strict got %v, want %v", got, tt.wantStrict)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package formats
+
+import (
+	"sync"
+	"time"
+)
+
+// timezones caches each name Timezone has loaded, as loading a location reads the zone database. Invalid names are not
+// cached, so arbitrary input can not grow the cache.
+var timezones sync.Map
+
+// Timezone reports whether s is the name of a location in the IANA Time Zone database that time.LoadLocation can
+// load, such as America/New_York or UTC. The empty string and Local are not accepted, as they are not zone names.
+//
+// The zone database of the system is used unless the time/tzdata package is imported, or the program is built with
+// -tags timetzdata, which embeds a copy of the database in the program.
+func Timezone(s string) bool {
+	if s == "" || s == "Local" {
+		return false
+	}
+	if _, ok := timezones.Load(s); ok {
+		return true
+	}
+	if _, err := time.LoadLocation(s); err != nil {
+		return false
+	}
+	timezones.Store(s, struct{}{})
+	return true
+}
@@ -0,0 +1,31 @@
+package formats_test
+
+import (
+	"testing"
+	_ "time/tzdata"
+
+	"github.com/codyoss/verify"
+)
+
+func TestTimezone(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"zone", "America/New_York", true},
+		{"utc", "UTC", true},
+		{"repeated", "America/New_York", true},
+		{"unknown", "Mars/Olympus_Mons", false},
+		{"local", "Local", false},
+		{"empty", "", false},
+		{"path", "../etc/passwd", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "timezone") == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package formats
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// UTF8 reports whether s consists entirely of valid UTF-8 encoded runes.
+func UTF8(s string) bool {
+	return utf8.ValidString(s)
+}
+
+// NFC reports whether s is valid UTF-8 in Unicode Normalization Form C, so it compares and indexes the same as any
+// other NFC text with the same characters.
+func NFC(s string) bool {
+	return utf8.ValidString(s) && norm.NFC.IsNormalString(s)
+}
@@ -0,0 +1,34 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestUnicode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		wantUTF8 bool
+		wantNFC  bool
+	}{
+		{"ascii", "gopher", true, true},
+		{"composed", "caf\u00e9", true, true},
+		{"decomposed", "cafe\u0301", true, false},
+		{"invalid", "caf\xe9", false, false},
+		{"invalid bytes", []byte{0xff, 0xfe}, false, false},
+		{"valid bytes", []byte("日本"), true, true},
+		{"empty", "", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, "utf8") == nil; got != tt.wantUTF8 {
+				t.Errorf("utf8 got %v, want %v", got, tt.wantUTF8)
+			}
+			if got := verify.Var(tt.input, "nfc") == nil; got != tt.wantNFC {
+				t.Errorf("nfc got %v, want %v", got, tt.wantNFC)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package formats
+
+import (
+	"net/url"
+	"strings"
+)
+
+// urlFormat builds the url format, or the uri format if uri is set. param is a space separated list of the schemes
+// allowed, or empty to allow any.
+func urlFormat(uri bool) func(param string) (func(string) bool, error) {
+	return func(param string) (func(string) bool, error) {
+		valid := URL
+		if uri {
+			valid = URI
+		}
+		schemes := strings.Fields(param)
+		if len(schemes) == 0 {
+			return valid, nil
+		}
+		return func(s string) bool {
+			if !valid(s) {
+				return false
+			}
+			scheme, _, _ := strings.Cut(s, ":")
+			for _, allowed := range schemes {
+				if strings.EqualFold(scheme, allowed) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+}
+
+// URL reports whether s is an absolute URL with both a scheme and a host, such as https://example.com/path.
+func URL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// URI reports whether s is an absolute URI, which has a scheme but need not have a host, such as
+// mailto:gopher@example.com or urn:isbn:0451450523.
+func URI(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && (u.Opaque != "" || u.Host != "" || u.Path != "")
+}
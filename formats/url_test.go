@@ -0,0 +1,41 @@
+package formats_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		rules string
+		want  bool
+	}{
+		{"works", "https://example.com/path?q=1", "url", true},
+		{"works port", "http://localhost:8080", "url", true},
+		{"empty", "", "url", false},
+		{"no scheme", "example.com/path", "url", false},
+		{"relative", "/path", "url", false},
+		{"no host", "mailto:gopher@example.com", "url", false},
+		{"invalid", "https://exa mple.com", "url", false},
+		{"scheme allowed", "https://example.com", "url=https", true},
+		{"scheme allowed case insensitive", "HTTPS://example.com", "url=https", true},
+		{"scheme not allowed", "http://example.com", "url=https", false},
+		{"one of several schemes", "ws://example.com", "url=http https ws", true},
+		{"uri works", "mailto:gopher@example.com", "uri", true},
+		{"uri works urn", "urn:isbn:0451450523", "uri", true},
+		{"uri works url", "https://example.com", "uri", true},
+		{"uri relative", "/path", "uri", false},
+		{"uri scheme only", "mailto:", "uri", false},
+		{"uri scheme not allowed", "urn:isbn:0451450523", "uri=mailto", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verify.Var(tt.input, tt.rules) == nil; got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
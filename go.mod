@@ -1 +1,8 @@
 module github.com/codyoss/verify
+
+go 1.20
+
+require (
+	golang.org/x/net v0.21.0
+	golang.org/x/text v0.14.0
+)
@@ -0,0 +1,50 @@
+package verify
+
+import "reflect"
+
+// Failure describes a failed rule, as passed to the functions registered with OnFailure.
+type Failure struct {
+	// Type is the struct type holding the field that failed, or nil if the value verified was not a struct field, such
+	// as a value passed to Var.
+	Type reflect.Type
+	// Error is the FieldError reported for the failure.
+	Error *FieldError
+	// Warning is set if the rule has the warn: prefix or is named by WithDryRun, so the failure is reported as a warning
+	// rather than an error.
+	Warning bool
+}
+
+// OnFailure registers fn to be called for each failure on the default Validator used by the package level functions.
+// See Validator.OnFailure for details.
+func OnFailure(fn func(Failure)) {
+	defaultValidator.OnFailure(fn)
+}
+
+// OnFailure registers fn to be called for each rule that fails while the Validator verifies a value, including the
+// failures reported by struct validation functions, Verify methods, and rules reported as warnings. This allows events
+// or alerts to be emitted for failures without wrapping every call site. fn is called synchronously, in the order the
+// failures are found, by the goroutine verifying the value, so it should return quickly and must be safe for concurrent
+// use. It must not modify the FieldError. Functions are called in the order they were registered.
+func (vd *Validator) OnFailure(fn func(Failure)) {
+	vd.mu.Lock()
+	defer vd.mu.Unlock()
+	vd.failureFuncs = append(vd.failureFuncs, fn)
+}
+
+// notify calls the functions registered with OnFailure for fe, a failure of a field of the struct type t.
+func (vd *Validator) notify(t reflect.Type, fe *FieldError, warning bool) {
+	vd.mu.RLock()
+	funcs := vd.failureFuncs
+	vd.mu.RUnlock()
+	for _, fn := range funcs {
+		fn(Failure{Type: t, Error: fe, Warning: warning})
+	}
+}
+
+// typeOf returns the type of v, or nil if v is the zero Value.
+func typeOf(v reflect.Value) reflect.Type {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Type()
+}
@@ -0,0 +1,72 @@
+package verify_test
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+type hookItem struct {
+	SKU string `verify:"required"`
+}
+
+type hookOrder struct {
+	Name  string     `verify:"required,warn:minSize=3"`
+	Items []hookItem `verify:"dive"`
+	Total int
+}
+
+func TestOnFailure(t *testing.T) {
+	vd := verify.New()
+	vd.RegisterStructValidation(func(sl *verify.StructLevel) {
+		if sl.Value.Interface().(hookOrder).Total < 0 {
+			sl.ReportError("Total", "positive", errors.New("negative total"))
+		}
+	}, hookOrder{})
+
+	var mu sync.Mutex
+	var got []string
+	vd.OnFailure(func(f verify.Failure) {
+		mu.Lock()
+		defer mu.Unlock()
+		name := "<nil>"
+		if f.Type != nil {
+			name = f.Type.Name()
+		}
+		s := name + " " + f.Error.Field + " " + f.Error.Tag
+		if f.Warning {
+			s += " warning"
+		}
+		got = append(got, s)
+	})
+	var calls int
+	vd.OnFailure(func(verify.Failure) { calls++ })
+
+	if err := vd.It(hookOrder{Name: "ab", Items: []hookItem{{SKU: "a"}, {}}, Total: -1}); err == nil {
+		t.Fatal("expected an error")
+	}
+	want := []string{
+		"hookOrder Name minSize warning",
+		"hookItem Items[1].SKU required",
+		"hookOrder Total positive",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if calls != len(want) {
+		t.Errorf("expected every registered function to be called, got %d calls", calls)
+	}
+
+	got = nil
+	if err := vd.Var("", "required"); err == nil || !reflect.DeepEqual(got, []string{"<nil> value required"}) {
+		t.Errorf("expected a failure without a struct type, got %v", got)
+	}
+
+	got = nil
+	if err := vd.It(hookOrder{Name: "abc"}); err != nil || got != nil {
+		t.Errorf("expected no failures, got %v and %v", err, got)
+	}
+}
@@ -0,0 +1,82 @@
+// Package httpjson writes the errors returned by the verify package as HTTP responses, so services share a single
+// mapping from validation failures to response bodies. Failures are rendered as RFC 7807 problem details:
+//
+//	if err := verify.It(req); err != nil {
+//		httpjson.WriteError(w, err)
+//		return
+//	}
+package httpjson
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/codyoss/verify"
+)
+
+// ContentType is the media type of the problem details written by WriteError.
+const ContentType = "application/problem+json"
+
+// Problem is the RFC 7807 problem details object written by WriteError.
+type Problem struct {
+	// Type is a URI identifying the kind of problem. It is omitted, which means about:blank, so Title is the text of
+	// Status.
+	Type   string `json:"type,omitempty"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	// Errors holds an entry for each failure of a ValidationError.
+	Errors []FieldProblem `json:"errors,omitempty"`
+	// Truncated is set if the ValidationError held more failures than were reported.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// FieldProblem describes a single failure of a ValidationError.
+type FieldProblem struct {
+	// Field is the path of the field that failed, such as Items[2].SKU.
+	Field string `json:"field"`
+	// Pointer is the location of the field as a JSON Pointer, set if the Validator was created with
+	// verify.WithJSONPointer.
+	Pointer string `json:"pointer,omitempty"`
+	// Code is the stable code of the failure, such as REQUIRED.
+	Code string `json:"code,omitempty"`
+	// Detail is the message of the failure.
+	Detail string `json:"detail"`
+}
+
+// NewProblem returns the problem details for err along with the status code they describe. A *verify.ValidationError
+// is described with the status 422 Unprocessable Entity and an entry in Errors for each failure. Any other error,
+// including a *verify.ConfigError, is a fault of the server rather than the request, so it is described with the status
+// 500 Internal Server Error and no detail that could leak its internals.
+func NewProblem(err error) *Problem {
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		return &Problem{Title: http.StatusText(http.StatusInternalServerError), Status: http.StatusInternalServerError}
+	}
+
+	p := &Problem{
+		Title:     http.StatusText(http.StatusUnprocessableEntity),
+		Status:    http.StatusUnprocessableEntity,
+		Detail:    "The request has invalid fields.",
+		Errors:    make([]FieldProblem, len(ve.Errors)),
+		Truncated: ve.Truncated,
+	}
+	for i, fe := range ve.Errors {
+		p.Errors[i] = FieldProblem{Field: fe.Field, Pointer: fe.Pointer, Code: fe.Code, Detail: fe.Error()}
+	}
+	return p
+}
+
+// WriteError writes the problem details for err, as described by NewProblem, to w with the status they describe and
+// the application/problem+json content type. It does nothing if err is nil. It returns the error writing the body, if
+// any, such as when the client has disconnected, so it may be logged; the status has already been sent by then.
+func WriteError(w http.ResponseWriter, err error) error {
+	if err == nil {
+		return nil
+	}
+	p := NewProblem(err)
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
@@ -0,0 +1,104 @@
+package httpjson_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/codyoss/verify"
+	"github.com/codyoss/verify/httpjson"
+)
+
+func TestWriteError(t *testing.T) {
+	type Item struct {
+		SKU string `json:"sku" verify:"required"`
+	}
+	type Order struct {
+		Name  string `json:"name" verify:"required"`
+		Items []Item `json:"items" verify:"dive"`
+	}
+	type Invalid struct {
+		A bool `verify:"min=1"`
+	}
+	vd := verify.New(verify.WithFieldNameTag("json"), verify.WithJSONPointer())
+
+	tests := []struct {
+		name string
+		err  error
+		want httpjson.Problem
+	}{
+		{"validation error", vd.It(Order{Items: []Item{{}}}), httpjson.Problem{
+			Title:  "Unprocessable Entity",
+			Status: http.StatusUnprocessableEntity,
+			Detail: "The request has invalid fields.",
+			Errors: []httpjson.FieldProblem{
+				{Field: "name", Pointer: "/name", Code: "REQUIRED", Detail: "name is required but is set to zero value"},
+				{Field: "items[0].sku", Pointer: "/items/0/sku", Code: "REQUIRED",
+					Detail: "items[0].sku is required but is set to zero value"},
+			},
+		}},
+		{"truncated", verify.New(verify.WithMaxErrors(1)).It(Order{Items: []Item{{}}}), httpjson.Problem{
+			Title:  "Unprocessable Entity",
+			Status: http.StatusUnprocessableEntity,
+			Detail: "The request has invalid fields.",
+			Errors: []httpjson.FieldProblem{
+				{Field: "Name", Code: "REQUIRED", Detail: "Name is required but is set to zero value"},
+			},
+			Truncated: true,
+		}},
+		{"config error", vd.It(Invalid{}), httpjson.Problem{
+			Title:  "Internal Server Error",
+			Status: http.StatusInternalServerError,
+		}},
+		{"other error", errors.New("database unavailable"), httpjson.Problem{
+			Title:  "Internal Server Error",
+			Status: http.StatusInternalServerError,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			if err := httpjson.WriteError(rec, tt.err); err != nil {
+				t.Fatal(err)
+			}
+
+			if rec.Code != tt.want.Status {
+				t.Errorf("want status %d, got %d", tt.want.Status, rec.Code)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != httpjson.ContentType {
+				t.Errorf("want content type %s, got %s", httpjson.ContentType, ct)
+			}
+			var got httpjson.Problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("want %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	if err := httpjson.WriteError(rec, nil); err != nil || rec.Body.Len() != 0 {
+		t.Errorf("expected nothing to be written for a nil error, got %s and %v", rec.Body, err)
+	}
+
+	fw := failingWriter{httptest.NewRecorder()}
+	if err := httpjson.WriteError(fw, errors.New("database unavailable")); !errors.Is(err, errWriteFailed) {
+		t.Errorf("expected the error writing the body, got %v", err)
+	}
+}
+
+var errWriteFailed = errors.New("client disconnected")
+
+// failingWriter is a ResponseWriter whose body can not be written.
+type failingWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWriteFailed
+}
@@ -0,0 +1,67 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// The errors below are wrapped by the error returned when verification is aborted because a value exceeds a limit set
+// by WithMaxDepth or WithMaxElements.
+var (
+	ErrMaxDepth    = errors.New("verify: value is nested deeper than the maximum depth")
+	ErrMaxElements = errors.New("verify: value has more elements than the maximum")
+)
+
+// WithMaxDepth makes the Validator abort with an error wrapping ErrMaxDepth, rather than a ValidationError, when a value
+// is nested more than n levels below the struct being verified, so maliciously deep payloads can not exhaust the stack.
+// Each slice or array with dive, and each struct reached through dive or embedding, is one level, so the fields of the
+// struct being verified are at depth zero and the fields of the structs in a slice field with dive are at depth two. An
+// n of zero or less does not limit the depth, which is the default.
+func WithMaxDepth(n int) Option {
+	return func(vd *Validator) {
+		vd.maxDepth = n
+	}
+}
+
+// WithMaxElements makes the Validator abort with an error wrapping ErrMaxElements, rather than a ValidationError, when
+// the slices and arrays with dive in a value hold more than n elements in total, counting those nested within other
+// elements, so huge payloads can not consume unbounded CPU. The elements are counted before any of them are verified.
+// An n of zero or less does not limit the number of elements, which is the default.
+func WithMaxElements(n int) Option {
+	return func(vd *Validator) {
+		vd.maxElements = n
+	}
+}
+
+// descend moves st one level deeper before verifying the elements or the fields of the value at loc, returning an error
+// if that exceeds the Validator's maximum depth. It should be followed by a call to ascend.
+func (vd *Validator) descend(st *state, loc location) error {
+	if vd.maxDepth > 0 && st.depth >= vd.maxDepth {
+		return fmt.Errorf("%w of %d: %s", ErrMaxDepth, vd.maxDepth, loc.name())
+	}
+	st.depth++
+	return nil
+}
+
+// ascend moves st back one level once the elements or the fields of a value have been verified.
+func (st *state) ascend() {
+	st.depth--
+}
+
+// countElements adds the n elements of the value at loc to the count of elements verified by st, returning an error if
+// that exceeds the Validator's maximum number of elements.
+func (vd *Validator) countElements(st *state, n int, loc location) error {
+	if vd.maxElements <= 0 {
+		return nil
+	}
+	// the workers of verifyElemsParallel add to the count of the state that started them
+	count := st.elements
+	if count == nil {
+		count = &st.elementCount
+	}
+	if atomic.AddInt64(count, int64(n)) > int64(vd.maxElements) {
+		return fmt.Errorf("%w of %d: %s", ErrMaxElements, vd.maxElements, loc.name())
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+type limitsNode struct {
+	Name     string        `verify:"required"`
+	Children []*limitsNode `verify:"dive"`
+}
+
+// limitsChain returns n nodes, each the only child of the one before it.
+func limitsChain(n int) *limitsNode {
+	head := &limitsNode{Name: "n"}
+	for i := 1; i < n; i++ {
+		head = &limitsNode{Name: "n", Children: []*limitsNode{head}}
+	}
+	return head
+}
+
+func TestWithMaxDepth(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxDepth int
+		input    *limitsNode
+		wantErr  error
+		wantMsg  string
+	}{
+		{"unlimited", 0, limitsChain(1000), nil, ""},
+		{"at the limit", 4, limitsChain(3), nil, ""},
+		{"past the limit", 4, limitsChain(4), verify.ErrMaxDepth,
+			"verify: value is nested deeper than the maximum depth of 4: Children[0].Children[0].Children"},
+		{"dive and the struct of each element are levels", 3, limitsChain(3), verify.ErrMaxDepth,
+			"verify: value is nested deeper than the maximum depth of 3: Children[0].Children[0]"},
+		{"failures are reported within the limit", 4, &limitsNode{Children: []*limitsNode{{}}}, verify.ErrRequired, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.New(verify.WithMaxDepth(tt.maxDepth)).It(tt.input)
+			if (tt.wantErr == nil) != (err == nil) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantMsg != "" && err.Error() != tt.wantMsg {
+				t.Errorf("want message %q, got %q", tt.wantMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestWithMaxElements(t *testing.T) {
+	nested := &limitsNode{Name: "n", Children: []*limitsNode{
+		{Name: "n", Children: []*limitsNode{{Name: "n"}, {Name: "n"}}},
+		{Name: "n", Children: []*limitsNode{{Name: "n"}}},
+	}}
+
+	tests := []struct {
+		name        string
+		maxElements int
+		opts        []verify.Option
+		wantErr     bool
+	}{
+		{"unlimited", 0, nil, false},
+		{"at the limit", 5, nil, false},
+		{"nested elements are counted", 4, nil, true},
+		{"parallel dive at the limit", 5, []verify.Option{verify.WithParallelDive(1, 2)}, false},
+		{"parallel dive past the limit", 4, []verify.Option{verify.WithParallelDive(1, 2)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vd := verify.New(append(tt.opts, verify.WithMaxElements(tt.maxElements))...)
+			err := vd.It(nested)
+			if tt.wantErr != errors.Is(err, verify.ErrMaxElements) || !tt.wantErr && err != nil {
+				t.Errorf("want ErrMaxElements %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	err := verify.New(verify.WithMaxElements(2)).Var(make([]string, 3), "dive,required")
+	if !errors.Is(err, verify.ErrMaxElements) {
+		t.Errorf("expected Var to fail with ErrMaxElements before verifying the elements, got %v", err)
+	}
+	var ve *verify.ValidationError
+	if errors.As(err, &ve) {
+		t.Errorf("expected the limit to abort verification rather than report a ValidationError, got %v", err)
+	}
+}
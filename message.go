@@ -0,0 +1,150 @@
+package verify
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// The placeholders that may be used in a message registered with RegisterMessage.
+const (
+	placeholderField = "{field}"
+	placeholderParam = "{param}"
+	placeholderValue = "{value}"
+)
+
+// RegisterMessage registers template as the message for failures of the sub-tag tag on the default Validator used by
+// the package level functions. See Validator.RegisterMessage for details.
+func RegisterMessage(tag, template string) {
+	defaultValidator.RegisterMessage(tag, template)
+}
+
+// RegisterMessage registers template as the message reported in place of the generated one whenever a field fails the
+// sub-tag tag, which may be a built-in sub-tag, a custom validation, or a format. The placeholders {field}, {param},
+// and {value} in template are replaced with the name of the field, the value specified for the sub-tag, and the value
+// of the field, for example "{field} must be at least {param} characters". A msg sub-tag on the field, or a message
+// supplied by the MessageProvider of its struct, takes precedence over a registered message. Registering a tag again
+// replaces its template. RegisterMessage panics if tag is empty or template contains a placeholder other than those
+// above.
+func (vd *Validator) RegisterMessage(tag, template string) {
+	if tag == "" {
+		panic("verify: invalid message tag \"\"")
+	}
+	if p, ok := unknownPlaceholder(template); ok {
+		panic(fmt.Sprintf("verify: message for %q has unknown placeholder %s", tag, p))
+	}
+
+	vd.mu.Lock()
+	defer vd.mu.Unlock()
+	if vd.messages == nil {
+		vd.messages = make(map[string]string)
+	}
+	vd.messages[tag] = template
+	vd.clearCache()
+}
+
+// lookupMessage returns the template registered for tag, if there is one.
+func (vd *Validator) lookupMessage(tag string) (string, bool) {
+	vd.mu.RLock()
+	defer vd.mu.RUnlock()
+	template, ok := vd.messages[tag]
+	return template, ok
+}
+
+// MessageProvider is implemented by struct types that supply their own messages for the failures of their fields, so a
+// package can own the wording of its types without registering messages on a Validator. VerifyMessages returns a
+// template, using the placeholders supported by RegisterMessage, keyed by the name of a field followed by a dot and a
+// sub-tag, such as "Name.required", to replace the message of that sub-tag, or by the name of a field alone to replace
+// the message of any sub-tag it fails. Fields are named as they are declared in Go, regardless of WithFieldNameTag.
+//
+// VerifyMessages is called once, on the zero value of the type, when the type is compiled. Its messages take precedence
+// over those registered with RegisterMessage, while a msg sub-tag takes precedence over both.
+type MessageProvider interface {
+	VerifyMessages() map[string]string
+}
+
+var messageProviderType = reflect.TypeOf((*MessageProvider)(nil)).Elem()
+
+// structMessages returns the messages of the struct type t if it implements MessageProvider, checking that each names
+// a field of t and uses only supported placeholders.
+func structMessages(t reflect.Type) (map[string]string, error) {
+	if !reflect.PtrTo(t).Implements(messageProviderType) {
+		return nil, nil
+	}
+	messages := reflect.New(t).Interface().(MessageProvider).VerifyMessages()
+	for key, template := range messages {
+		name, _, _ := strings.Cut(key, ".")
+		if _, ok := t.FieldByName(name); !ok {
+			return nil, &ConfigError{Type: t, Field: name, Err: fmt.Errorf("message for %q names an unknown field", key)}
+		}
+		if p, ok := unknownPlaceholder(template); ok {
+			return nil, &ConfigError{Type: t, Field: name, Err: fmt.Errorf("message for %q has unknown placeholder %s",
+				key, p)}
+		}
+	}
+	return messages, nil
+}
+
+// applyMessages sets the template of each rule in vr, and in the rules of its elements, for the field name from
+// messages, as returned by structMessages.
+func applyMessages(vr *valueRules, name string, messages map[string]string) {
+	for ; vr != nil; vr = vr.elem {
+		for _, r := range vr.rules {
+			if template, ok := messages[name+"."+r.tag]; ok {
+				r.template = template
+			} else if template, ok := messages[name]; ok {
+				r.template = template
+			}
+		}
+	}
+}
+
+// unknownPlaceholder returns the first placeholder in template that is not supported, if there is one.
+func unknownPlaceholder(template string) (string, bool) {
+	for {
+		start := strings.IndexByte(template, '{')
+		if start == -1 {
+			return "", false
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end == -1 {
+			return "", false
+		}
+		switch p := template[start : start+end+1]; p {
+		case placeholderField, placeholderParam, placeholderValue:
+		default:
+			return p, true
+		}
+		template = template[start+end+1:]
+	}
+}
+
+// formatValue formats v for a message, quoting strings and following pointers.
+func formatValue(v interface{}) string {
+	f := reflect.ValueOf(v)
+	for f.Kind() == reflect.Ptr {
+		if f.IsNil() {
+			return "nil"
+		}
+		f = f.Elem()
+	}
+	if f.Kind() == reflect.String {
+		return strconv.Quote(f.String())
+	}
+	return fmt.Sprint(interfaceOf(f))
+}
+
+// renderMessage replaces the placeholders in template for the field name with the given value that failed a sub-tag
+// with param.
+func renderMessage(template, name, param string, value interface{}) string {
+	f := reflect.ValueOf(value)
+	for f.Kind() == reflect.Ptr && !f.IsNil() {
+		f = f.Elem()
+	}
+	return strings.NewReplacer(
+		placeholderField, name,
+		placeholderParam, param,
+		placeholderValue, fmt.Sprint(interfaceOf(f)),
+	).Replace(template)
+}
@@ -0,0 +1,142 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestRegisterMessage(t *testing.T) {
+	vd := verify.New()
+	vd.RegisterMessage("minSize", "{field} must be at least {param} characters, got {value}")
+	vd.RegisterMessage("required", "please fill in {field}")
+	vd.RegisterMessage("even", "{field} must be even")
+	vd.RegisterValidation("even", func(f verify.Field) error {
+		if f.Value.Int()%2 != 0 {
+			return errors.New("odd")
+		}
+		return nil
+	})
+
+	type A struct {
+		A string  `verify:"minSize=3"`
+		B *string `verify:"minSize=3"`
+		C int     `verify:"required"`
+		D int     `verify:"even"`
+		E string  `verify:"minSize=3,msg=is too short"`
+		F string  `verify:"maxSize=1"`
+	}
+	ab := "ab"
+
+	err := vd.It(A{A: "ab", B: &ab, D: 1, E: "ab", F: "ab"})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []string{
+		"A must be at least 3 characters, got ab",
+		"B must be at least 3 characters, got ab",
+		"please fill in C",
+		"D must be even",
+		"E is too short",
+		"F has a length greater than 1",
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i].Error(); got != w {
+			t.Errorf("error %d: want %q, got %q", i, w, got)
+		}
+	}
+	if !errors.Is(err, verify.ErrRequired) {
+		t.Error("expected the sentinel error to be unchanged")
+	}
+
+	if err := verify.New().Var("ab", "minSize=3"); err == nil || err.Error() !=
+		"verify found the following errors: [value has a length less than 3]" {
+		t.Errorf("expected messages to be registered per Validator, got %v", err)
+	}
+}
+
+func TestRegisterMessageInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		template string
+	}{
+		{"empty tag", "", "{field} is invalid"},
+		{"unknown placeholder", "min", "{field} must be at least {min}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected RegisterMessage to panic")
+				}
+			}()
+			verify.New().RegisterMessage(tt.tag, tt.template)
+		})
+	}
+}
+
+type messageOrder struct {
+	Name  string   `verify:"required,maxSize=3"`
+	Email string   `verify:"required"`
+	Tags  []string `verify:"dive,minSize=2"`
+	Note  string   `verify:"required,msg=is missing"`
+	ID    int      `verify:"required"`
+}
+
+func (messageOrder) VerifyMessages() map[string]string {
+	return map[string]string{
+		"Name.required": "an order needs a name",
+		"Email":         "{field} is not a usable address",
+		"Tags.minSize":  "tag {value} is shorter than {param}",
+		"Note":          "ignored in favor of msg",
+	}
+}
+
+type messageInvalid struct {
+	Name string `verify:"required"`
+}
+
+func (*messageInvalid) VerifyMessages() map[string]string {
+	return map[string]string{"Nmae": "{field} is wrong"}
+}
+
+func TestMessageProvider(t *testing.T) {
+	vd := verify.New()
+	vd.RegisterMessage("required", "please fill in {field}")
+
+	err := vd.It(messageOrder{Name: "long", Tags: []string{"ok", "x"}})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []string{
+		"Name has a length greater than 3",
+		"Email is not a usable address",
+		"tag x is shorter than 2",
+		"Note is missing",
+		"please fill in ID",
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i].Error(); got != w {
+			t.Errorf("error %d: want %q, got %q", i, w, got)
+		}
+	}
+	if err := vd.It(messageOrder{Tags: []string{"ok"}, Email: "a", Note: "b", ID: 1}); err == nil ||
+		err.Error() != "verify found the following errors: [an order needs a name]" {
+		t.Errorf("expected the field and sub-tag message, got %v", err)
+	}
+
+	var ce *verify.ConfigError
+	if err := vd.It(messageInvalid{}); !errors.As(err, &ce) || ce.Field != "Nmae" {
+		t.Errorf("expected a *verify.ConfigError for the unknown field, got %v", err)
+	}
+}
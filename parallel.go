@@ -0,0 +1,104 @@
+package verify
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// WithParallelDive makes the Validator verify the elements of a slice or array with dive across workers goroutines
+// when it has more than minElements elements, such as the rows of a bulk import. Each worker verifies a contiguous run
+// of elements and their failures are merged in index order, so the result is the same as verifying them one by one. A
+// workers of zero or less uses runtime.GOMAXPROCS. Only the outermost such slice is split; slices within its elements
+// are verified by the worker that reaches them.
+//
+// Custom validations, struct validation functions, Verify methods, and the functions registered with OnFailure must be
+// safe for concurrent use. Once WithFailMode or WithMaxErrors stops verification, failures found by other workers past
+// that point are discarded, though functions registered with OnFailure may already have been called for them.
+func WithParallelDive(minElements, workers int) Option {
+	return func(vd *Validator) {
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		vd.parallelMin, vd.parallelWorkers = minElements, workers
+	}
+}
+
+// parallel reports whether the n elements of a value with dive should be verified by verifyElemsParallel.
+func (vd *Validator) parallel(st *state, n int) bool {
+	return vd.parallelWorkers > 1 && n > vd.parallelMin && !st.worker
+}
+
+// verifyElemsParallel verifies each element of f, the value at loc, against vr in the same way as verifyValue, with the
+// elements split between the Validator's workers.
+func (vd *Validator) verifyElemsParallel(st *state, vr *valueRules, parent, f reflect.Value, parentLoc,
+	loc location) error {
+	n := f.Len()
+	workers := vd.parallelWorkers
+	if workers > n {
+		workers = n
+	}
+	size := (n + workers - 1) / workers
+
+	states := make([]*state, 0, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += size {
+		end := start + size
+		if end > n {
+			end = n
+		}
+		ws := newState(st.ctx)
+		ws.worker, ws.record, ws.depth = true, st.record, st.depth
+		ws.visiting = append(ws.visiting, st.visiting...)
+		if ws.elements = st.elements; ws.elements == nil {
+			ws.elements = &st.elementCount
+		}
+		states = append(states, ws)
+
+		wg.Add(1)
+		go func(w int, ws *state, start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				if err := ws.canceled(); err != nil {
+					errs[w] = err
+					return
+				}
+				eloc := ws.index(loc, i)
+				err := vd.verifyValue(ws, vr, parent, f.Index(i), parentLoc, eloc)
+				ws.release(eloc)
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				if vd.stop(ws) {
+					return
+				}
+			}
+		}(len(states)-1, ws, start, end)
+	}
+	wg.Wait()
+
+	defer func() {
+		for _, ws := range states {
+			putState(ws)
+		}
+	}()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	// merge in index order, stopping where verifying the elements one by one would have stopped
+	for _, ws := range states {
+		st.warnings = append(st.warnings, ws.warnings...)
+		st.fields = append(st.fields, ws.fields...)
+		for _, fe := range ws.errs {
+			st.errs = append(st.errs, fe)
+			if vd.stop(st) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
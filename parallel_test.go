@@ -0,0 +1,76 @@
+package verify_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+type parallelRow struct {
+	SKU   string `verify:"required,warn:maxSize=4"`
+	Count int    `verify:"min=1"`
+	Tags  []int  `verify:"dive,min=1"`
+}
+
+type parallelImport struct {
+	Name string        `verify:"required"`
+	Rows []parallelRow `verify:"minSize=1,dive"`
+}
+
+func TestWithParallelDive(t *testing.T) {
+	in := parallelImport{Rows: make([]parallelRow, 1000)}
+	for i := range in.Rows {
+		in.Rows[i] = parallelRow{SKU: "sku", Count: 1, Tags: []int{1}}
+		switch {
+		case i%97 == 0:
+			in.Rows[i].SKU = ""
+		case i%89 == 0:
+			in.Rows[i].Tags = []int{1, 0}
+		case i%83 == 0:
+			in.Rows[i].SKU = "too long"
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts []verify.Option
+	}{
+		{"evaluate all", nil},
+		{"first rule", []verify.Option{verify.WithFailMode(verify.FailFirstRule)}},
+		{"first field", []verify.Option{verify.WithFailMode(verify.FailFirstField)}},
+		{"max errors", []verify.Option{verify.WithMaxErrors(5)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := verify.New(tt.opts...).Evaluate(in)
+			for _, workers := range []int{0, 3, 8} {
+				vd := verify.New(append(tt.opts, verify.WithParallelDive(100, workers))...)
+				got := vd.Evaluate(in)
+				if want.Err == nil || got.Err == nil || got.Err.Error() != want.Err.Error() {
+					t.Errorf("workers %d: want %v, got %v", workers, want.Err, got.Err)
+				}
+				if err := vd.It(in); err == nil || err.Error() != want.Err.Error() {
+					t.Errorf("workers %d: want %v from It, got %v", workers, want.Err, err)
+				}
+				if tt.opts == nil && (!reflect.DeepEqual(got.Warnings, want.Warnings) ||
+					!reflect.DeepEqual(got.Fields, want.Fields)) {
+					t.Errorf("workers %d: expected the same warnings and outcomes as verifying sequentially", workers)
+				}
+			}
+		})
+	}
+
+	small := parallelImport{Name: "a", Rows: in.Rows[1:10]}
+	if err := verify.New(verify.WithParallelDive(100, 4)).It(small); err != nil {
+		t.Errorf("expected a slice under the threshold to pass, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := verify.New(verify.WithParallelDive(100, 4)).ItContext(ctx, in); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context's error, got %v", err)
+	}
+}
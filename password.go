@@ -0,0 +1,120 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unicode"
+	"unicode/utf8"
+)
+
+const tagPassword = "password"
+
+// ErrPassword is wrapped by each FieldError for a password that does not meet its policy.
+var ErrPassword = errors.New("verify: field does not meet the password policy")
+
+// PasswordPolicy describes the requirements a password must meet to pass a password sub-tag.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters a password must have.
+	MinLength int
+	// MaxLength is the maximum number of characters a password may have, or 0 for no maximum.
+	MaxLength int
+	// RequireUpper, RequireLower, RequireDigit, and RequireSymbol require a password to contain at least one upper
+	// case letter, lower case letter, digit, or symbol or punctuation character.
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// Banned reports whether a password may not be used, for example because it appears in a list of common or
+	// breached passwords. It is only called for passwords that meet every other requirement. It may be nil.
+	Banned func(password string) bool
+}
+
+// DefaultPasswordPolicy is the policy of a password sub-tag given no value. It follows NIST SP 800-63B, requiring 8
+// to 64 characters without composition rules.
+var DefaultPasswordPolicy = PasswordPolicy{MinLength: 8, MaxLength: 64}
+
+// passwordError describes the requirement a password did not meet, wrapping ErrPassword.
+type passwordError string
+
+func (e passwordError) Error() string { return string(e) }
+
+func (e passwordError) Unwrap() error { return ErrPassword }
+
+// Check returns an error describing the first requirement of the policy that password does not meet, or nil if it
+// meets them all. The error wraps ErrPassword.
+func (p PasswordPolicy) Check(password string) error {
+	n := utf8.RuneCountInString(password)
+	if n < p.MinLength {
+		return passwordError(fmt.Sprintf("must have at least %d characters", p.MinLength))
+	}
+	if p.MaxLength > 0 && n > p.MaxLength {
+		return passwordError(fmt.Sprintf("must have at most %d characters", p.MaxLength))
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		upper = upper || unicode.IsUpper(r)
+		lower = lower || unicode.IsLower(r)
+		digit = digit || unicode.IsDigit(r)
+		symbol = symbol || unicode.IsPunct(r) || unicode.IsSymbol(r)
+	}
+	switch {
+	case p.RequireUpper && !upper:
+		return passwordError("must contain an upper case letter")
+	case p.RequireLower && !lower:
+		return passwordError("must contain a lower case letter")
+	case p.RequireDigit && !digit:
+		return passwordError("must contain a digit")
+	case p.RequireSymbol && !symbol:
+		return passwordError("must contain a symbol")
+	}
+	if p.Banned != nil && p.Banned(password) {
+		return passwordError("is not allowed")
+	}
+	return nil
+}
+
+func init() {
+	builtinRules[tagPassword] = derefRule(buildPassword)
+}
+
+// RegisterPasswordPolicy registers p under name on the default Validator used by the package level functions. See
+// Validator.RegisterPasswordPolicy for details.
+func RegisterPasswordPolicy(name string, p PasswordPolicy) {
+	defaultValidator.RegisterPasswordPolicy(name, p)
+}
+
+// RegisterPasswordPolicy registers p under name, so a tag like `verify:"password=admin"` verifies a field against it.
+// A password sub-tag given no value uses DefaultPasswordPolicy. Registering a name again replaces its policy.
+func (vd *Validator) RegisterPasswordPolicy(name string, p PasswordPolicy) {
+	vd.mu.Lock()
+	defer vd.mu.Unlock()
+	if vd.passwords == nil {
+		vd.passwords = make(map[string]PasswordPolicy)
+	}
+	vd.passwords[name] = p
+	vd.clearCache()
+}
+
+func buildPassword(s ruleSpec) (*rule, error) {
+	if s.typ.Kind() != reflect.String {
+		return nil, errors.New("password can only be used with types: string")
+	}
+	p := DefaultPasswordPolicy
+	if s.param != "" {
+		s.vd.mu.RLock()
+		var ok bool
+		p, ok = s.vd.passwords[s.param]
+		s.vd.mu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("%s uses password policy %q, which is not registered", s.field, s.param)
+		}
+	}
+	return &rule{
+		tag:    s.tag,
+		param:  s.param,
+		err:    ErrPassword,
+		custom: func(f Field) error { return p.Check(f.Value.String()) },
+	}, nil
+}
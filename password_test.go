@@ -0,0 +1,87 @@
+package verify_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestPassword(t *testing.T) {
+	type A struct {
+		User  string  `verify:"password"`
+		Admin *string `verify:"password=admin"`
+	}
+
+	vd := verify.New()
+	vd.RegisterPasswordPolicy("admin", verify.PasswordPolicy{
+		MinLength:     12,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		Banned:        func(p string) bool { return strings.Contains(strings.ToLower(p), "password") },
+	})
+	pw := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		input   A
+		wantMsg string
+	}{
+		{"works", A{User: "correct horse", Admin: pw("Tr0ub4dor&3xyz")}, ""},
+		{"works nil pointer", A{User: "correct horse"}, ""},
+		{"too short", A{User: "short"}, "User failed password: must have at least 8 characters"},
+		{"too long", A{User: strings.Repeat("a", 65)}, "User failed password: must have at most 64 characters"},
+		{"counts characters", A{User: "ééééééé"}, "User failed password: must have at least 8 characters"},
+		{"no upper", A{User: "correct horse", Admin: pw("tr0ub4dor&3xyz")},
+			"Admin failed password: must contain an upper case letter"},
+		{"no lower", A{User: "correct horse", Admin: pw("TR0UB4DOR&3XYZ")},
+			"Admin failed password: must contain a lower case letter"},
+		{"no digit", A{User: "correct horse", Admin: pw("Troubador&xyz")}, "Admin failed password: must contain a digit"},
+		{"no symbol", A{User: "correct horse", Admin: pw("Tr0ub4dor3xyz")}, "Admin failed password: must contain a symbol"},
+		{"banned", A{User: "correct horse", Admin: pw("MyPassword&1234")}, "Admin failed password: is not allowed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := vd.It(tt.input)
+			if tt.wantMsg == "" {
+				if got != nil {
+					t.Errorf("want nil, got %v", got)
+				}
+				return
+			}
+			var ve *verify.ValidationError
+			if !errors.As(got, &ve) || len(ve.Errors) != 1 || ve.Errors[0].Error() != tt.wantMsg {
+				t.Fatalf("want %q, got %v", tt.wantMsg, got)
+			}
+			if !errors.Is(got, verify.ErrPassword) {
+				t.Errorf("want ErrPassword, got %v", got)
+			}
+		})
+	}
+}
+
+func TestPasswordConfig(t *testing.T) {
+	type A struct {
+		A string `verify:"password=missing"`
+	}
+	type B struct {
+		A []byte `verify:"password"`
+	}
+	for _, v := range []interface{}{A{}, B{}} {
+		if err := verify.Check(v); err == nil {
+			t.Errorf("Check(%T) want an error, got nil", v)
+		}
+	}
+}
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	if err := verify.DefaultPasswordPolicy.Check("long enough"); err != nil {
+		t.Errorf("want nil, got %v", err)
+	}
+	if err := verify.DefaultPasswordPolicy.Check("short"); !errors.Is(err, verify.ErrPassword) {
+		t.Errorf("want ErrPassword, got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNotPrecompiled is returned when a Validator created with CompileEager verifies a struct type that was not
+// registered with Precompile or Compile.
+var ErrNotPrecompiled = errors.New("verify: struct type was not precompiled")
+
+// CompileMode controls when a Validator compiles the rules of a struct type.
+type CompileMode int
+
+const (
+	// CompileLazy compiles the rules of each struct type the first time a value of it is verified, so only the types
+	// in use take up memory. This is the default.
+	CompileLazy CompileMode = iota
+	// CompileEager only verifies the struct types registered with Precompile or Compile, whose rules are compiled when
+	// they are registered, so no request pays for compiling rules and invalid tags are found at startup. Verifying a
+	// value of any other struct type returns an error wrapping ErrNotPrecompiled.
+	CompileEager
+)
+
+// WithCompileMode sets when the Validator compiles the rules of a struct type. The default is CompileLazy, while
+// CompileEager suits deployments such as serverless functions that register their types at startup and should not
+// compile rules while serving a request.
+func WithCompileMode(m CompileMode) Option {
+	return func(vd *Validator) {
+		vd.compileMode = m
+	}
+}
+
+// Precompile compiles the rules of the struct type of each of the values in the same way as the package level
+// Precompile, using the options the Validator was configured with.
+func (vd *Validator) Precompile(values ...interface{}) error {
+	for _, v := range values {
+		t := reflect.TypeOf(v)
+		for t != nil && t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct {
+			return errInvalidKind
+		}
+		if _, err := vd.precompile(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// precompile registers the struct type t as precompiled and returns its compiled rules.
+func (vd *Validator) precompile(t reflect.Type) (*structRules, error) {
+	sr, err := vd.compileStruct(t)
+	if err != nil {
+		return nil, err
+	}
+	vd.precompiled.Store(t, struct{}{})
+	return sr, nil
+}
+
+// checkPrecompiled returns an error if the Validator only verifies precompiled struct types and t is not one of them.
+func (vd *Validator) checkPrecompiled(t reflect.Type) error {
+	if vd.compileMode != CompileEager {
+		return nil
+	}
+	if _, ok := vd.precompiled.Load(t); !ok {
+		return fmt.Errorf("%w: %s", ErrNotPrecompiled, t)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package verify_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+type precompileItem struct {
+	SKU string `verify:"required"`
+}
+
+type precompileOrder struct {
+	ID    string           `verify:"code"`
+	Items []precompileItem `verify:"dive"`
+}
+
+func TestWithCompileMode(t *testing.T) {
+	eager := verify.New(verify.WithCompileMode(verify.CompileEager))
+	if err := eager.It(precompileOrder{}); !errors.Is(err, verify.ErrNotPrecompiled) {
+		t.Errorf("expected an unregistered type to fail with ErrNotPrecompiled, got %v", err)
+	}
+	if err := eager.Precompile((*precompileOrder)(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr error
+	}{
+		{"registered type passes", precompileOrder{Items: []precompileItem{{SKU: "a"}}}, nil},
+		{"registered type fails", &precompileOrder{Items: []precompileItem{{}}}, verify.ErrRequired},
+		{"reached type is not registered", precompileItem{}, verify.ErrNotPrecompiled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := eager.It(tt.input)
+			if (tt.wantErr == nil) != (err == nil) || !errors.Is(err, tt.wantErr) {
+				t.Errorf("want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	// discarding the compiled rules of a registered type compiles them again when it is next verified
+	eager.RegisterAlias("code", "len=3")
+	if err := eager.It(precompileOrder{ID: "ab"}); !errors.Is(err, verify.ErrLen) {
+		t.Errorf("expected the registered type to be compiled again, got %v", err)
+	}
+
+	if _, err := eager.Compile(reflect.TypeOf(precompileItem{})); err != nil {
+		t.Fatal(err)
+	}
+	if err := eager.It(precompileItem{SKU: "a"}); err != nil {
+		t.Errorf("expected Compile to register the type, got %v", err)
+	}
+
+	if err := verify.New().It(precompileItem{SKU: "a"}); err != nil {
+		t.Errorf("expected lazy compilation by default, got %v", err)
+	}
+}
+
+func TestPrecompile(t *testing.T) {
+	type Bad struct {
+		A string `verify:"minSize"`
+	}
+
+	tests := []struct {
+		name    string
+		values  []interface{}
+		wantErr bool
+	}{
+		{"structs", []interface{}{precompileOrder{}, &precompileItem{}}, false},
+		{"invalid tags", []interface{}{precompileItem{}, Bad{}}, true},
+		{"not a struct", []interface{}{1}, true},
+		{"nil", []interface{}{nil}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.New().Precompile(tt.values...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("wantErr is %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	var ce *verify.ConfigError
+	if err := verify.Precompile(struct {
+		A int `verify:"min=a"`
+	}{}); !errors.As(err, &ce) {
+		t.Errorf("expected a *verify.ConfigError, got %v", err)
+	}
+}
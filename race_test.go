@@ -0,0 +1,6 @@
+//go:build race
+
+package verify_test
+
+// raceEnabled is set if the tests are run with the race detector, which allocates on its own.
+const raceEnabled = true
@@ -0,0 +1,55 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+)
+
+const tagRegex = "regex"
+
+// ErrRegex is wrapped by each FieldError for a failed regex sub-tag.
+var ErrRegex = errors.New("verify: field does not match regex")
+
+var errValueTypeRegex = errors.New("regex can only be used with types: string")
+
+// regexCache holds the patterns compiled by regex sub-tags, keyed by the pattern, so a pattern used by several fields,
+// struct types, or Validators is only compiled once. It is bounded by SetPatternCacheSize.
+var regexCache = newLRUCache[string, *regexp.Regexp](defaultPatternCacheSize)
+
+func init() {
+	builtinRules[tagRegex] = derefRule(buildRegex)
+}
+
+func buildRegex(s ruleSpec) (*rule, error) {
+	if !s.hasParam || s.param == "" {
+		return nil, errors.New("regex must specify a pattern")
+	}
+	if s.typ.Kind() != reflect.String {
+		return nil, errValueTypeRegex
+	}
+	re, err := compileRegex(s.param)
+	if err != nil {
+		return nil, fmt.Errorf("%s has an invalid regex: %w", s.field, err)
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrRegex,
+		check: func(f reflect.Value) bool { return re.MatchString(f.String()) },
+		msg:   func(name string) string { return fmt.Sprintf("%s does not match regex %s", name, s.param) },
+	}, nil
+}
+
+// compileRegex returns the compiled pattern from regexCache, compiling and storing it first if needed.
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexCache.get(pattern); ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return regexCache.add(pattern, re), nil
+}
@@ -0,0 +1,63 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestRegex(t *testing.T) {
+	type A struct {
+		A string `verify:"regex"`
+	}
+	type B struct {
+		A int `verify:"regex=^a$"`
+	}
+	type C struct {
+		A string `verify:"regex=^(a$"`
+	}
+	type D struct {
+		A string   `verify:"regex=^[A-Z]{2}\\d{6}$"`
+		B *string  `verify:"regex='^a{1,3}$',maxSize=2"`
+		C []string `verify:"dive,regex='^[a-c]{1,2}$',minSize=1"`
+	}
+	aaa, aa := "aaa", "aa"
+
+	for _, v := range []interface{}{A{}, B{}, C{}} {
+		if err := verify.Check(v); err == nil {
+			t.Errorf("Check(%T) want an error, got nil", v)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr error
+	}{
+		{"no match", D{A: "AB12345"}, verify.ErrRegex},
+		{"quoted pattern no match", D{A: "AB123456", B: new(string)}, verify.ErrRegex},
+		{"sub-tag after quoted pattern", D{A: "AB123456", B: &aaa}, verify.ErrMaxSize},
+		{"dive no match", D{A: "AB123456", C: []string{"abc"}}, verify.ErrRegex},
+		{"works", D{A: "AB123456", B: &aa, C: []string{"ab", "c"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr == nil && got != nil) || (tt.wantErr != nil && !errors.Is(got, tt.wantErr)) {
+				t.Errorf("want %v, got %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestRegexAlias(t *testing.T) {
+	vd := verify.New()
+	vd.RegisterAlias("code", "required,regex='^[a-z]{1,3}$'")
+	if err := vd.Var("abc", "code"); err != nil {
+		t.Errorf("want nil, got %v", err)
+	}
+	if err := vd.Var("abcd", "code"); !errors.Is(err, verify.ErrRegex) {
+		t.Errorf("want ErrRegex, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package verify
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+)
+
+// Result is the outcome of verifying a value with Evaluate.
+type Result struct {
+	// Err is the error It would return for the value: a *ValidationError if any field failed, a *ConfigError if the
+	// tags are invalid, or nil.
+	Err error
+	// Warnings holds a FieldError for each failure of a sub-tag with the warn: prefix, in the same order as the
+	// FieldErrors of a ValidationError. Warnings are not included in Err.
+	Warnings []*FieldError
+	// Fields holds the outcome of each field, and each element of a field with dive, that has rules, in the order they
+	// were verified. Fields that were not reached because verification stopped early, as set by WithFailMode or
+	// WithMaxErrors, are not included.
+	Fields []FieldResult
+}
+
+// FieldResult is the outcome of verifying a single field.
+type FieldResult struct {
+	// Field is the path of the field, in the same form as the Field of a FieldError.
+	Field string
+	// Pointer is the location of the field as a JSON Pointer, set if the Validator was created with WithJSONPointer.
+	Pointer string
+	// Outcome is the most severe outcome of the field's rules, or Skipped if it has none.
+	Outcome Outcome
+	// Rules holds the outcome of each rule of the field, in the order they are verified: the sub-tags that require the
+	// field to be set, required and its conditional forms, first, and the rest in the order they are written. Failures
+	// reported by a struct validation function or a Verify method are included as failed rules of the field they name.
+	Rules []RuleResult
+}
+
+// RuleResult is the outcome of a single rule of a field.
+type RuleResult struct {
+	// Tag and Param are the sub-tag of the rule and the value specified for it.
+	Tag   string
+	Param string
+	// Outcome is the outcome of the rule.
+	Outcome Outcome
+	// Error is the FieldError reported if the rule Failed or Warned.
+	Error *FieldError
+}
+
+// Outcome is the outcome of verifying a rule or field. Outcomes are ordered by severity.
+type Outcome int
+
+const (
+	// Skipped means the rule was not verified, because the field was empty and the rule followed omitempty, or because
+	// an earlier rule failed and the Validator's FailMode stopped verifying the field.
+	Skipped Outcome = iota
+	// Passed means the rule was verified and the field passed it.
+	Passed
+	// Warned means the field failed a rule with the warn: prefix.
+	Warned
+	// Failed means the field failed the rule.
+	Failed
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Skipped:
+		return "skipped"
+	case Passed:
+		return "passed"
+	case Warned:
+		return "warned"
+	case Failed:
+		return "failed"
+	}
+	return "Outcome(" + strconv.Itoa(int(o)) + ")"
+}
+
+// Evaluate verifies v in the same way as the package level Evaluate, using the options the Validator was configured
+// with.
+func (vd *Validator) Evaluate(v interface{}) Result {
+	st := newState(context.Background())
+	defer putState(st)
+	st.record = true
+	return vd.evaluateValue(st, reflect.ValueOf(v))
+}
+
+// result returns the Result of a call to verify a value that st holds the state of.
+func (vd *Validator) result(st *state) Result {
+	r := Result{Warnings: st.warnings, Fields: st.fields}
+	if vd.sortErrors && r.Warnings != nil {
+		(&ValidationError{Errors: r.Warnings}).Sort()
+	}
+	if len(st.errs) > 0 {
+		// st.errs is reused once st is returned to statePool
+		errs := make([]*FieldError, len(st.errs))
+		copy(errs, st.errs)
+		r.Err = vd.validationError(errs)
+	}
+	return r
+}
+
+// recordField adds a FieldResult for the field at loc and returns its index in st.fields, or -1 if st is not recording
+// outcomes.
+func (st *state) recordField(loc location) int {
+	if !st.record {
+		return -1
+	}
+	return st.addField(loc.name(), loc.pointer())
+}
+
+// addField adds a FieldResult for the field at path name and returns its index in st.fields.
+func (st *state) addField(name, pointer string) int {
+	st.fields = append(st.fields, FieldResult{Field: name, Pointer: pointer})
+	return len(st.fields) - 1
+}
+
+// findField returns the index in st.fields of the FieldResult for the field at path name, adding one if the field has
+// not been recorded, or -1 if st is not recording outcomes.
+func (st *state) findField(name, pointer string) int {
+	if !st.record {
+		return -1
+	}
+	for i := len(st.fields) - 1; i >= 0; i-- {
+		if st.fields[i].Field == name {
+			return i
+		}
+	}
+	return st.addField(name, pointer)
+}
+
+// recordRule adds rr to the rules of the FieldResult at index i, unless i is -1.
+func (st *state) recordRule(i int, rr RuleResult) {
+	if i == -1 {
+		return
+	}
+	fr := &st.fields[i]
+	fr.Rules = append(fr.Rules, rr)
+	if rr.Outcome > fr.Outcome {
+		fr.Outcome = rr.Outcome
+	}
+}
+
+// skipRules records each of rules as Skipped for the FieldResult at index i, unless i is -1.
+func (st *state) skipRules(i int, rules []*rule) {
+	if i == -1 {
+		return
+	}
+	for _, r := range rules {
+		st.recordRule(i, RuleResult{Tag: r.tag, Param: r.param, Outcome: Skipped})
+	}
+}
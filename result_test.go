@@ -0,0 +1,165 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestEvaluateWarnings(t *testing.T) {
+	type A struct {
+		A string `verify:"required,warn:maxSize=3"`
+		B []int  `verify:"dive,warn:min=1"`
+		C string `verify:"warn:required,msg=should be set"`
+		D int    `verify:"min=1"`
+	}
+
+	tests := []struct {
+		name         string
+		input        A
+		wantErr      []string
+		wantWarnings []string
+	}{
+		{"passes", A{A: "abc", B: []int{1}, C: "c", D: 1}, nil, nil},
+		{"only warnings", A{A: "abcd", B: []int{1, 0}, D: 1}, nil, []string{
+			"A has a length greater than 3", "B[1] has value less than min 1", "C should be set",
+		}},
+		{"errors and warnings", A{B: []int{0}, C: "c"}, []string{
+			"A is required but is set to zero value", "D has value less than min 1",
+		}, []string{"B[0] has value less than min 1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := verify.Evaluate(tt.input)
+			var ve *verify.ValidationError
+			if tt.wantErr == nil {
+				if res.Err != nil {
+					t.Fatalf("expected no error, got %v", res.Err)
+				}
+			} else if !errors.As(res.Err, &ve) {
+				t.Fatalf("expected a *verify.ValidationError, got %v", res.Err)
+			}
+			if ve != nil && len(ve.Errors) != len(tt.wantErr) {
+				t.Fatalf("expected %d errors, got %v", len(tt.wantErr), res.Err)
+			}
+			for i, w := range tt.wantErr {
+				if got := ve.Errors[i].Error(); got != w {
+					t.Errorf("error %d: want %q, got %q", i, w, got)
+				}
+			}
+			if len(res.Warnings) != len(tt.wantWarnings) {
+				t.Fatalf("expected %d warnings, got %v", len(tt.wantWarnings), res.Warnings)
+			}
+			for i, w := range tt.wantWarnings {
+				if got := res.Warnings[i].Error(); got != w {
+					t.Errorf("warning %d: want %q, got %q", i, w, got)
+				}
+			}
+			if err := verify.It(tt.input); (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("expected It to ignore warnings, got %v", err)
+			}
+		})
+	}
+
+	// warnings do not stop a field or count towards the limit on errors
+	type B struct {
+		A string `verify:"warn:minSize=3,len=5"`
+		B string `verify:"required"`
+	}
+	vd := verify.New(verify.WithFailMode(verify.FailFirstField), verify.WithMaxErrors(1))
+	res := vd.Evaluate(B{A: "ab", B: ""})
+	var ve *verify.ValidationError
+	if !errors.As(res.Err, &ve) || len(ve.Errors) != 1 || ve.Errors[0].Field != "A" || len(res.Warnings) != 1 {
+		t.Errorf("expected the warning and the first error, got %v and %v", res.Err, res.Warnings)
+	}
+}
+
+func TestEvaluateWarningsInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{"dive", struct {
+			A []int `verify:"warn:dive,min=1"`
+		}{}},
+		{"omitempty", struct {
+			A int `verify:"warn:omitempty,min=1"`
+		}{}},
+		{"msg", struct {
+			A int `verify:"min=1,warn:msg=too small"`
+		}{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ce *verify.ConfigError
+			if res := verify.Evaluate(tt.input); !errors.As(res.Err, &ce) {
+				t.Errorf("expected a *verify.ConfigError, got %v", res.Err)
+			}
+		})
+	}
+}
+
+func TestEvaluateFields(t *testing.T) {
+	type A struct {
+		Name  string `verify:"required,minSize=2"`
+		Nick  string `verify:"omitempty,minSize=2"`
+		Tags  []int  `verify:"maxSize=3,dive,warn:min=1"`
+		Plain string
+		Start int `verify:"min=1"`
+		End   int
+	}
+	vd := verify.New(verify.WithFailMode(verify.FailFirstRule))
+	vd.RegisterStructValidation(func(sl *verify.StructLevel) {
+		a := sl.Value.Interface().(A)
+		if a.End < a.Start {
+			sl.ReportError("Start", "beforeEnd", errors.New("start is after end"))
+		}
+	}, A{})
+
+	type rule struct {
+		tag     string
+		outcome verify.Outcome
+	}
+	type field struct {
+		name    string
+		outcome verify.Outcome
+		rules   []rule
+	}
+	want := []field{
+		{"Name", verify.Failed, []rule{{"required", verify.Failed}, {"minSize", verify.Skipped}}},
+		{"Nick", verify.Skipped, []rule{{"minSize", verify.Skipped}}},
+		{"Tags", verify.Passed, []rule{{"maxSize", verify.Passed}}},
+		{"Tags[0]", verify.Passed, []rule{{"min", verify.Passed}}},
+		{"Tags[1]", verify.Warned, []rule{{"min", verify.Warned}}},
+		{"Start", verify.Failed, []rule{{"min", verify.Passed}, {"beforeEnd", verify.Failed}}},
+	}
+
+	res := vd.Evaluate(A{Tags: []int{1, 0}, Start: 2})
+	if res.Err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(res.Fields) != len(want) {
+		t.Fatalf("expected %d fields, got %+v", len(want), res.Fields)
+	}
+	for i, w := range want {
+		got := res.Fields[i]
+		if got.Field != w.name || got.Outcome != w.outcome || len(got.Rules) != len(w.rules) {
+			t.Errorf("field %d: want %s %v with %d rules, got %+v", i, w.name, w.outcome, len(w.rules), got)
+			continue
+		}
+		for j, wr := range w.rules {
+			gr := got.Rules[j]
+			if gr.Tag != wr.tag || gr.Outcome != wr.outcome || (gr.Error != nil) != (wr.outcome >= verify.Warned) {
+				t.Errorf("field %s rule %d: want %s %v, got %+v", w.name, j, wr.tag, wr.outcome, gr)
+			}
+		}
+	}
+
+	if res := vd.Evaluate(A{Name: "ab", Start: 1, End: 1}); res.Err != nil || res.Fields[0].Outcome != verify.Passed {
+		t.Errorf("expected the fields to pass, got %v and %+v", res.Err, res.Fields)
+	}
+	if got := verify.Failed.String(); got != "failed" {
+		t.Errorf("want failed, got %s", got)
+	}
+}
@@ -0,0 +1,658 @@
+package verify
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// rule is a single sub-tag compiled for a field of a specific type.
+type rule struct {
+	tag   string
+	param string
+	// err is the sentinel error wrapped by the FieldError reported when the rule fails.
+	err error
+	// check reports whether f passes the rule.
+	check func(f reflect.Value) bool
+	// checkField reports whether f, a field of the struct parent, passes the rule. It is set instead of check by rules
+	// that compare f against another field.
+	checkField func(parent, f reflect.Value) bool
+	// msg describes the failure of the field with the given name.
+	msg func(name string) string
+	// custom is a function such as one registered with RegisterValidation, set instead of check and msg. The FieldError
+	// reported wraps the error it returns rather than err.
+	custom func(Field) error
+	// template is the message registered with RegisterMessage for the sub-tag, if any, which replaces msg when a
+	// FieldError is reported.
+	template string
+	// code is the Code of the FieldErrors reported by the rule.
+	code string
+	// warn is set if the FieldErrors reported by the rule are warnings, when the sub-tag has the warn: prefix.
+	warn bool
+	// fast is the form of check used by a Validator created with WithUnsafeFieldAccess, set by the built-in sub-tags
+	// that support it on strings, bools, and numbers.
+	fast fastCheck
+}
+
+// presence reports whether the rule verifies that a value is set, as required does.
+func (r *rule) presence() bool {
+	return r.err == ErrRequired
+}
+
+// verify verifies f, a field of the struct parent at loc, against the rule, returning a FieldError if it fails.
+func (r *rule) verify(st *state, parent, f reflect.Value, loc location) *FieldError {
+	switch {
+	case r.custom != nil:
+		name := loc.name()
+		err := r.custom(Field{Name: name, Value: f, Param: r.param, ctx: st.ctx})
+		if err == nil {
+			return nil
+		}
+		fe := newFieldError(f, name, r, fmt.Sprintf("%s failed %s: %v", name, r.tag, err))
+		fe.err = err
+		return fe
+	case r.checkField != nil:
+		if r.checkField(parent, f) {
+			return nil
+		}
+	default:
+		if r.check(f) {
+			return nil
+		}
+	}
+	name := loc.name()
+	return newFieldError(f, name, r, r.msg(name))
+}
+
+// ruleSpec is a sub-tag parsed from a struct field tag, along with the field it was found on.
+type ruleSpec struct {
+	// vd is the Validator compiling the sub-tag, for sub-tags that depend on its registrations.
+	vd *Validator
+	// parent is the struct type the field belongs to, or nil if the sub-tag is not on a struct field.
+	parent reflect.Type
+	// field is the name of the field, used to describe configuration errors.
+	field    string
+	typ      reflect.Type
+	tag      string
+	param    string
+	hasParam bool
+}
+
+// ruleBuilder compiles a ruleSpec into a rule, returning an error if the sub-tag is invalid for the field. A nil rule
+// with a nil error means the sub-tag has nothing to verify for the field's type.
+type ruleBuilder func(s ruleSpec) (*rule, error)
+
+var builtinRules = map[string]ruleBuilder{
+	tagMinSize:  derefRule(buildMinSize),
+	tagMaxSize:  derefRule(buildMaxSize),
+	tagLen:      derefRule(buildLen),
+	tagMinRunes: derefRule(buildMinRunes),
+	tagMaxRunes: derefRule(buildMaxRunes),
+	tagMin:      derefRule(buildMin),
+	tagMax:      derefRule(buildMax),
+	tagGt:       derefRule(buildGt),
+	tagGte:      derefRule(buildMin),
+	tagLt:       derefRule(buildLt),
+	tagLte:      derefRule(buildMax),
+	tagBetween:  derefRule(buildBetween),
+	tagMultiple: derefRule(buildMultipleOf),
+	tagUnique:   derefRule(buildUnique),
+	tagRequired: buildRequired,
+	tagNotBlank: derefRule(buildNotBlank),
+	tagOneOf:    derefRule(buildOneOf),
+	tagEq:       derefRule(buildEq),
+	tagNe:       derefRule(buildNe),
+}
+
+// derefRule wraps build so that it may also be used on pointer fields, verifying the value pointed to. Nil pointers
+// pass the rule; required should be used as well if a value must be set.
+func derefRule(build ruleBuilder) ruleBuilder {
+	var deref ruleBuilder
+	deref = func(s ruleSpec) (*rule, error) {
+		if s.typ.Kind() != reflect.Ptr {
+			return build(s)
+		}
+		s.typ = s.typ.Elem()
+		r, err := deref(s)
+		if r == nil || err != nil {
+			return r, err
+		}
+		if custom := r.custom; custom != nil {
+			r.custom = func(f Field) error {
+				if f.Value.IsNil() {
+					return nil
+				}
+				f.Value = f.Value.Elem()
+				return custom(f)
+			}
+			return r, nil
+		}
+		check := r.check
+		r.check = func(f reflect.Value) bool { return f.IsNil() || check(f.Elem()) }
+		// fast reads the value pointed to, so it can not be used on the pointer
+		r.fast = nil
+		return r, nil
+	}
+	return deref
+}
+
+func buildMinSize(s ruleSpec) (*rule, error) {
+	if !s.hasParam {
+		return nil, errMissingValueMinSize
+	}
+	min, err := strconv.Atoi(s.param)
+	if err != nil {
+		return nil, errConvertToNumberMinSize
+	}
+	if !hasLen(s.typ) {
+		return nil, errValueTypeMinSize
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrMinSize,
+		check: func(f reflect.Value) bool { return f.Len() >= min },
+		msg:   func(name string) string { return fmt.Sprintf("%s has a length less than %d", name, min) },
+		fast:  stringCheck(s.typ, func(v string) bool { return len(v) >= min }),
+	}, nil
+}
+
+func buildMaxSize(s ruleSpec) (*rule, error) {
+	if !s.hasParam {
+		return nil, errMissingValueMaxSize
+	}
+	max, err := strconv.Atoi(s.param)
+	if err != nil {
+		return nil, errConvertToNumberMaxSize
+	}
+	if !hasLen(s.typ) {
+		return nil, errValueTypeMaxSize
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrMaxSize,
+		check: func(f reflect.Value) bool { return f.Len() <= max },
+		msg:   func(name string) string { return fmt.Sprintf("%s has a length greater than %d", name, max) },
+		fast:  stringCheck(s.typ, func(v string) bool { return len(v) <= max }),
+	}, nil
+}
+
+func buildLen(s ruleSpec) (*rule, error) {
+	if !s.hasParam {
+		return nil, errMissingValueLen
+	}
+	n, err := strconv.Atoi(s.param)
+	if err != nil {
+		return nil, errConvertToNumberLen
+	}
+	if !hasLen(s.typ) {
+		return nil, errValueTypeLen
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrLen,
+		check: func(f reflect.Value) bool { return f.Len() == n },
+		msg:   func(name string) string { return fmt.Sprintf("%s does not have a length of %d", name, n) },
+		fast:  stringCheck(s.typ, func(v string) bool { return len(v) == n }),
+	}, nil
+}
+
+func buildMinRunes(s ruleSpec) (*rule, error) {
+	if !s.hasParam {
+		return nil, errMissingValueMinRunes
+	}
+	min, err := strconv.Atoi(s.param)
+	if err != nil {
+		return nil, errConvertToNumberMinRunes
+	}
+	if s.typ.Kind() != reflect.String {
+		return nil, errValueTypeMinRunes
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrMinRunes,
+		check: func(f reflect.Value) bool { return utf8.RuneCountInString(f.String()) >= min },
+		msg:   func(name string) string { return fmt.Sprintf("%s has fewer than %d characters", name, min) },
+	}, nil
+}
+
+func buildMaxRunes(s ruleSpec) (*rule, error) {
+	if !s.hasParam {
+		return nil, errMissingValueMaxRunes
+	}
+	max, err := strconv.Atoi(s.param)
+	if err != nil {
+		return nil, errConvertToNumberMaxRunes
+	}
+	if s.typ.Kind() != reflect.String {
+		return nil, errValueTypeMaxRunes
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrMaxRunes,
+		check: func(f reflect.Value) bool { return utf8.RuneCountInString(f.String()) <= max },
+		msg:   func(name string) string { return fmt.Sprintf("%s has more than %d characters", name, max) },
+	}, nil
+}
+
+// bound describes a tag that limits the value of a number, such as min or gt.
+type bound struct {
+	err        error
+	errMissing error
+	errConvert error
+	errType    error
+	// pass reports whether a field that compares to the tag's value as c, -1, 0, or +1, passes.
+	pass func(c int) bool
+	// desc describes the failure, for example "less than min".
+	desc string
+}
+
+var (
+	minBound = bound{ErrMin, errMissingValueMin, errConvertToNumberMin, errValueTypeMin,
+		func(c int) bool { return c >= 0 }, "less than min"}
+	maxBound = bound{ErrMax, errMissingValueMax, errConvertToNumberMax, errValueTypeMax,
+		func(c int) bool { return c <= 0 }, "greater than max"}
+	gtBound = bound{ErrGt, errMissingValueGt, errConvertToNumberGt, errValueTypeGt,
+		func(c int) bool { return c > 0 }, "not greater than"}
+	ltBound = bound{ErrLt, errMissingValueLt, errConvertToNumberLt, errValueTypeLt,
+		func(c int) bool { return c < 0 }, "not less than"}
+)
+
+func buildMin(s ruleSpec) (*rule, error) { return buildBound(s, minBound) }
+
+func buildMax(s ruleSpec) (*rule, error) { return buildBound(s, maxBound) }
+
+func buildGt(s ruleSpec) (*rule, error) { return buildBound(s, gtBound) }
+
+func buildLt(s ruleSpec) (*rule, error) { return buildBound(s, ltBound) }
+
+func buildBound(s ruleSpec, b bound) (*rule, error) {
+	if !s.hasParam {
+		return nil, b.errMissing
+	}
+	if s.typ == durationType {
+		return buildDurationBound(s, b)
+	}
+	i, f, isFloat, err := parseNumber(s.param)
+	if err != nil {
+		return nil, b.errConvert
+	}
+	r := &rule{tag: s.tag, param: s.param, err: b.err}
+	switch s.typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if isFloat {
+			return nil, fmt.Errorf("%s type is int while %s is float", s.field, s.tag)
+		}
+		r.check = func(v reflect.Value) bool { return b.pass(compareOrdered(v.Int(), i)) }
+		r.msg = func(name string) string { return fmt.Sprintf("%s has value %s %d", name, b.desc, i) }
+		r.fast = intCheck(s.typ, func(v int64) bool { return b.pass(compareOrdered(v, i)) })
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := parseUint(s)
+		if err != nil {
+			return nil, err
+		}
+		r.check = func(v reflect.Value) bool { return b.pass(compareOrdered(v.Uint(), u)) }
+		r.msg = func(name string) string { return fmt.Sprintf("%s has value %s %d", name, b.desc, u) }
+		r.fast = uintCheck(s.typ, func(v uint64) bool { return b.pass(compareOrdered(v, u)) })
+	case reflect.Float32, reflect.Float64:
+		if !isFloat {
+			return nil, fmt.Errorf("%s type is float while %s is int", s.field, s.tag)
+		}
+		r.check = func(v reflect.Value) bool { return b.pass(compareOrdered(v.Float(), f)) }
+		r.msg = func(name string) string { return fmt.Sprintf("%s has value %s %f", name, b.desc, f) }
+		r.fast = floatCheck(s.typ, func(v float64) bool { return b.pass(compareOrdered(v, f)) })
+	default:
+		return nil, b.errType
+	}
+	return r, nil
+}
+
+// buildDurationBound builds a bound for a time.Duration field, whose value is given as a duration string such as 5m.
+func buildDurationBound(s ruleSpec, b bound) (*rule, error) {
+	d, err := time.ParseDuration(s.param)
+	if err != nil {
+		return nil, fmt.Errorf("%s value %q must be a duration, for example 1s or 5m", s.tag, s.param)
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   b.err,
+		check: func(v reflect.Value) bool { return b.pass(compareOrdered(v.Int(), int64(d))) },
+		msg:   func(name string) string { return fmt.Sprintf("%s has value %s %s", name, b.desc, d) },
+	}, nil
+}
+
+func buildBetween(s ruleSpec) (*rule, error) {
+	if !s.hasParam {
+		return nil, errMissingValueBetween
+	}
+	lo, hi, ok := strings.Cut(s.param, ":")
+	if !ok {
+		return nil, errConvertToNumberBetween
+	}
+	for _, p := range []string{lo, hi} {
+		if s.typ == durationType {
+			if _, err := time.ParseDuration(p); err != nil {
+				return nil, errConvertToNumberBetween
+			}
+		} else if _, _, _, err := parseNumber(p); err != nil {
+			return nil, errConvertToNumberBetween
+		}
+	}
+	switch s.typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+	default:
+		return nil, errValueTypeBetween
+	}
+
+	// the range is verified as a min and max, but reported as a single failure
+	s.param = lo
+	min, err := buildBound(s, minBound)
+	if err != nil {
+		return nil, err
+	}
+	s.param = hi
+	max, err := buildBound(s, maxBound)
+	if err != nil {
+		return nil, err
+	}
+	var hv reflect.Value
+	if s.typ == durationType {
+		d, _ := time.ParseDuration(hi)
+		hv = reflect.ValueOf(d)
+	} else if hv, err = parseValue(s.typ, hi); err != nil {
+		return nil, fmt.Errorf("between value %q can not be used with field %s: %w", hi, s.field, err)
+	}
+	if !min.check(hv) {
+		return nil, fmt.Errorf("%s has a between range whose lower bound is greater than its upper bound", s.field)
+	}
+	return &rule{
+		tag:   s.tag,
+		param: lo + ":" + hi,
+		err:   ErrBetween,
+		check: func(f reflect.Value) bool { return min.check(f) && max.check(f) },
+		msg:   func(name string) string { return fmt.Sprintf("%s must be between %s and %s", name, lo, hi) },
+	}, nil
+}
+
+func buildMultipleOf(s ruleSpec) (*rule, error) {
+	if !s.hasParam {
+		return nil, errMissingValueMultipleOf
+	}
+	i, f, isFloat, err := parseNumber(s.param)
+	if err != nil {
+		return nil, errConvertToNumberMultipleOf
+	}
+	if i == 0 && f == 0 {
+		return nil, fmt.Errorf("%s has a multipleOf of zero", s.field)
+	}
+	r := &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrMultipleOf,
+		msg:   func(name string) string { return fmt.Sprintf("%s is not a multiple of %s", name, s.param) },
+	}
+	switch s.typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if isFloat {
+			return nil, fmt.Errorf("%s type is int while %s is float", s.field, s.tag)
+		}
+		r.check = func(v reflect.Value) bool { return v.Int()%i == 0 }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := parseUint(s)
+		if err != nil {
+			return nil, err
+		}
+		r.check = func(v reflect.Value) bool { return v.Uint()%u == 0 }
+	case reflect.Float32, reflect.Float64:
+		if !isFloat {
+			return nil, fmt.Errorf("%s type is float while %s is int", s.field, s.tag)
+		}
+		r.check = func(v reflect.Value) bool { return isMultiple(v.Float(), f) }
+	default:
+		return nil, errValueTypeMultipleOf
+	}
+	return r, nil
+}
+
+// isMultiple reports whether v is a multiple of m. Values such as 0.01 can not be represented exactly, so v is allowed
+// to be off from a multiple by a small relative tolerance.
+func isMultiple(v, m float64) bool {
+	q := v / m
+	return math.Abs(q-math.Round(q)) <= multipleTolerance*math.Max(1, math.Abs(q))
+}
+
+func buildUnique(s ruleSpec) (*rule, error) {
+	if s.typ.Kind() != reflect.Slice && s.typ.Kind() != reflect.Array {
+		return nil, errValueTypeUnique
+	}
+	r := &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrUnique,
+		msg:   func(name string) string { return fmt.Sprintf("%s contains duplicate values", name) },
+	}
+
+	// key returns the value of an element that must be unique, or false if the element should be ignored
+	key := func(e reflect.Value) (interface{}, bool) { return readable(e).Interface(), true }
+	et := s.typ.Elem()
+	if s.param != "" {
+		st := et
+		if st.Kind() == reflect.Ptr {
+			st = st.Elem()
+		}
+		if st.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%s can only use unique=%s on a slice or array of structs", s.field, s.param)
+		}
+		o, err := lookupOtherField(ruleSpec{parent: st, tag: s.tag}, s.param)
+		if err != nil {
+			return nil, err
+		}
+		key = func(e reflect.Value) (interface{}, bool) {
+			if e.Kind() == reflect.Ptr {
+				if e.IsNil() {
+					return nil, false
+				}
+				e = e.Elem()
+			}
+			return readable(o.value(e)).Interface(), true
+		}
+		et = o.typ
+		r.msg = func(name string) string { return fmt.Sprintf("%s contains duplicate values of %s", name, s.param) }
+	}
+	if !et.Comparable() {
+		return nil, fmt.Errorf("%s can not use unique as values of type %s can not be compared", s.field, et)
+	}
+
+	r.check = func(f reflect.Value) bool {
+		seen := make(map[interface{}]struct{}, f.Len())
+		for i := 0; i < f.Len(); i++ {
+			k, ok := key(f.Index(i))
+			if !ok {
+				continue
+			}
+			// an interface may hold a value that can not be used as a map key
+			if k != nil && !reflect.TypeOf(k).Comparable() {
+				continue
+			}
+			if _, dup := seen[k]; dup {
+				return false
+			}
+			seen[k] = struct{}{}
+		}
+		return true
+	}
+	return r, nil
+}
+
+func buildRequired(s ruleSpec) (*rule, error) {
+	r := &rule{
+		tag: s.tag,
+		err: ErrRequired,
+		msg: func(name string) string { return fmt.Sprintf("%s is required but is set to zero value", name) },
+	}
+	switch s.typ.Kind() {
+	case reflect.Func, reflect.Map, reflect.Slice:
+		r.check = func(f reflect.Value) bool { return !f.IsNil() }
+	case reflect.Array, reflect.Struct:
+		if s.typ != timeType {
+			return nil, nil
+		}
+		// a time.Time holds a location, so it is only zero based on IsZero
+		r.check = func(f reflect.Value) bool { return !readable(f).Interface().(time.Time).IsZero() }
+	case reflect.Float32, reflect.Float64:
+		// -0 is equal to zero, while IsZero only reports true for +0
+		r.check = func(f reflect.Value) bool { return f.Float() != 0 }
+	case reflect.Complex64, reflect.Complex128:
+		r.check = func(f reflect.Value) bool { return f.Complex() != 0 }
+	default:
+		r.check = func(f reflect.Value) bool { return !f.IsZero() }
+	}
+	r.fast = requiredCheck(s.typ)
+	return r, nil
+}
+
+func buildNotBlank(s ruleSpec) (*rule, error) {
+	if s.typ.Kind() != reflect.String {
+		return nil, errValueTypeNotBlank
+	}
+	return &rule{
+		tag:   s.tag,
+		err:   ErrNotBlank,
+		check: func(f reflect.Value) bool { return strings.TrimSpace(f.String()) != "" },
+		msg:   func(name string) string { return fmt.Sprintf("%s must not be blank", name) },
+	}, nil
+}
+
+func buildOneOf(s ruleSpec) (*rule, error) {
+	values := strings.Fields(s.param)
+	if len(values) == 0 {
+		return nil, errMissingValueOneOf
+	}
+	if !isScalar(s.typ) {
+		return nil, errValueTypeOneOf
+	}
+
+	// the set is keyed by the normalized value so named types match their underlying values
+	set := make(map[interface{}]struct{}, len(values))
+	for _, v := range values {
+		pv, err := parseValue(s.typ, v)
+		if err != nil {
+			return nil, fmt.Errorf("oneof value %q can not be used with field %s: %w", v, s.field, err)
+		}
+		set[normalize(pv)] = struct{}{}
+	}
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   ErrOneOf,
+		check: func(f reflect.Value) bool {
+			_, ok := set[normalize(f)]
+			return ok
+		},
+		msg: func(name string) string {
+			return fmt.Sprintf("%s must be one of [%s]", name, strings.Join(values, " "))
+		},
+	}, nil
+}
+
+func buildEq(s ruleSpec) (*rule, error) {
+	return buildEquality(s, true, ErrEq, errMissingValueEq, errValueTypeEq, "%s must be equal to %s")
+}
+
+func buildNe(s ruleSpec) (*rule, error) {
+	return buildEquality(s, false, ErrNe, errMissingValueNe, errValueTypeNe, "%s must not be equal to %s")
+}
+
+// buildEquality builds a rule that passes when the field is, or when equal is false is not, equal to the value of s.
+// format describes the failure given the field name and the value.
+func buildEquality(s ruleSpec, equal bool, sentinel, errMissing, errType error, format string) (*rule, error) {
+	if !s.hasParam {
+		return nil, errMissing
+	}
+	if !isScalar(s.typ) {
+		return nil, errType
+	}
+	pv, err := parseValue(s.typ, s.param)
+	if err != nil {
+		return nil, fmt.Errorf("%s value %q can not be used with field %s: %w", s.tag, s.param, s.field, err)
+	}
+	want := normalize(pv)
+	return &rule{
+		tag:   s.tag,
+		param: s.param,
+		err:   sentinel,
+		check: func(f reflect.Value) bool { return (normalize(f) == want) == equal },
+		msg:   func(name string) string { return fmt.Sprintf(format, name, s.param) },
+	}, nil
+}
+
+// isScalar reports whether t is a string, bool, int, uint, or float type, which may be compared to values given in a
+// tag.
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// normalize returns the value of f as a string, bool, int64, uint64, or float64 based on its kind.
+func normalize(f reflect.Value) interface{} {
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Bool:
+		return f.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return f.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return f.Uint()
+	case reflect.Float32, reflect.Float64:
+		return f.Float()
+	}
+	return nil
+}
+
+// hasLen reports whether values of t have a length.
+func hasLen(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return true
+	}
+	return false
+}
+
+// parseUint parses the param of s as a uint64, so values above the maximum int64 may be used with unsigned fields.
+func parseUint(s ruleSpec) (uint64, error) {
+	u, err := strconv.ParseUint(s.param, parseBase, parseBit)
+	if err != nil {
+		return 0, fmt.Errorf("%s type is uint while %s is not an unsigned int", s.field, s.tag)
+	}
+	return u, nil
+}
+
+// parseNumber parses s as an int64, falling back to a float64 if s is not an integer.
+func parseNumber(s string) (i int64, f float64, isFloat bool, err error) {
+	i, err = strconv.ParseInt(s, parseBase, parseBit)
+	if err == nil {
+		return i, 0, false, nil
+	}
+	f, err = strconv.ParseFloat(s, parseBit)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return 0, f, true, nil
+}
@@ -0,0 +1,44 @@
+//go:build go1.21
+
+package verify
+
+import (
+	"context"
+	"log/slog"
+)
+
+// WithLogger makes the Validator log each failed rule to l at level, with the attributes type, field, rule, and code,
+// along with pointer if the Validator was created with WithJSONPointer and warning for rules reported as warnings.
+// Values are never logged, even if the Validator was created with WithValueInMessage, so logs do not hold the data being
+// verified. Failures are logged in the same way as the
+// functions registered with OnFailure are called.
+func WithLogger(l *slog.Logger, level slog.Level) Option {
+	return func(vd *Validator) {
+		vd.failureFuncs = append(vd.failureFuncs, func(f Failure) {
+			logFailure(l, level, f)
+		})
+	}
+}
+
+func logFailure(l *slog.Logger, level slog.Level, f Failure) {
+	ctx := context.Background()
+	if !l.Enabled(ctx, level) {
+		return
+	}
+	attrs := make([]slog.Attr, 0, 6)
+	if f.Type != nil {
+		attrs = append(attrs, slog.String("type", f.Type.String()))
+	}
+	attrs = append(attrs,
+		slog.String("field", f.Error.Field),
+		slog.String("rule", f.Error.Tag),
+		slog.String("code", f.Error.Code),
+	)
+	if f.Error.Pointer != "" {
+		attrs = append(attrs, slog.String("pointer", f.Error.Pointer))
+	}
+	if f.Warning {
+		attrs = append(attrs, slog.Bool("warning", true))
+	}
+	l.LogAttrs(ctx, level, f.Error.message(), attrs...)
+}
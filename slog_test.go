@@ -0,0 +1,72 @@
+//go:build go1.21
+
+package verify_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestWithLogger(t *testing.T) {
+	type A struct {
+		Name  string `verify:"required"`
+		Email string `verify:"warn:maxSize=3,secret"`
+	}
+
+	var buf bytes.Buffer
+	l := slog.New(slog.NewJSONHandler(&buf, nil))
+	vd := verify.New(verify.WithLogger(l, slog.LevelWarn), verify.WithJSONPointer())
+	if err := vd.It(A{Email: "gopher@example.com"}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	want := []map[string]interface{}{
+		{"level": "WARN", "msg": "Name is required but is set to zero value", "type": "verify_test.A",
+			"field": "Name", "rule": "required", "code": "REQUIRED", "pointer": "/Name"},
+		{"level": "WARN", "msg": "Email has a length greater than 3", "type": "verify_test.A",
+			"field": "Email", "rule": "maxSize", "code": "MAX_SIZE", "pointer": "/Email", "warning": true},
+	}
+	dec := json.NewDecoder(&buf)
+	for i, w := range want {
+		var got map[string]interface{}
+		if err := dec.Decode(&got); err != nil {
+			t.Fatalf("record %d: %v", i, err)
+		}
+		delete(got, "time")
+		if !reflect.DeepEqual(got, w) {
+			t.Errorf("record %d: want %v, got %v", i, w, got)
+		}
+	}
+	if dec.More() {
+		t.Error("expected no more records")
+	}
+
+	buf.Reset()
+	type B struct {
+		Password string `verify:"minSize=20"`
+	}
+	vd = verify.New(verify.WithLogger(l, slog.LevelWarn), verify.WithValueInMessage(nil))
+	err := vd.It(B{Password: "hunter2"})
+	if err == nil || !strings.Contains(err.Error(), "hunter2") {
+		t.Fatalf("expected the value in the message of the error, got %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if msg := got["msg"]; msg != "Password has a length less than 20" {
+		t.Errorf("expected the value to be left out of the logged message, got %q", msg)
+	}
+
+	buf.Reset()
+	vd = verify.New(verify.WithLogger(l, slog.LevelDebug))
+	if err := vd.It(A{}); err == nil || buf.Len() != 0 {
+		t.Errorf("expected records below the handler's level to be dropped, got %s", buf.String())
+	}
+}
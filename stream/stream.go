@@ -0,0 +1,160 @@
+// Package stream verifies the elements of a large JSON document one at a time as they are decoded from a reader, so
+// import jobs can report the failures of every element of a multi-gigabyte file without loading it into memory. The
+// document may be a JSON array or a stream of JSON values, such as newline delimited JSON. As the elements are structs,
+// a JSON array in a stream of values is never an element itself, so its elements are decoded in turn instead, which
+// allows a stream of arrays, such as newline delimited JSON whose lines each hold a batch of elements:
+//
+//	d := stream.NewDecoder[Row](f, nil)
+//	for d.Next() {
+//		if e := d.Element(); e.Err != nil {
+//			log.Printf("row %d: %v", e.Index, e.Err)
+//		}
+//	}
+//	if err := d.Err(); err != nil {
+//		return err
+//	}
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/codyoss/verify"
+)
+
+// Element is an element of a document along with the outcome of verifying it.
+type Element[T any] struct {
+	// Index is the position of the element in the document, counting from zero, which is its position in the array or
+	// in the stream of values. The elements of a stream of arrays are counted across all of them.
+	Index int
+	// Value is the element decoded.
+	Value T
+	// Err is the error verifying Value, usually a *verify.ValidationError, or a *json.UnmarshalTypeError if the element
+	// does not match the type of Value, in which case the fields that do match are decoded but not verified. It is nil
+	// if the element is valid.
+	Err error
+}
+
+// Decoder decodes the elements of a JSON array, or of a stream of JSON values, from a reader one at a time and
+// verifies each of them. It reads no further ahead of the element being decoded than its buffer, so its memory does not
+// grow with the size of the document.
+type Decoder[T any] struct {
+	dec *json.Decoder
+	tv  *verify.TypedValidator[T]
+
+	// started is set once the start of the next value in the document has been read, and array if it is a JSON array
+	// whose elements are being decoded.
+	started bool
+	array   bool
+	elem    Element[T]
+	next    int
+	err     error
+}
+
+// NewDecoder returns a Decoder reading the document from r and verifying each element with tv. If tv is nil the
+// elements are verified by verify.For[T](). T must be a struct or a pointer to a struct with valid rules, otherwise
+// the Decoder reads nothing and Err returns the error.
+func NewDecoder[T any](r io.Reader, tv *verify.TypedValidator[T]) *Decoder[T] {
+	if tv == nil {
+		tv = verify.For[T]()
+	}
+	return &Decoder[T]{dec: json.NewDecoder(r), tv: tv, err: tv.Err()}
+}
+
+// Next decodes and verifies the next element, which is then returned by Element. It returns false once the document
+// ends, or if it can not be decoded or the rules of T are invalid, in which case Err returns the error.
+func (d *Decoder[T]) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	for !d.started || d.array && !d.dec.More() {
+		if d.started {
+			// consume the closing bracket, so an array that is cut short is reported, and go on to the next value
+			if _, d.err = d.dec.Token(); d.err != nil {
+				return false
+			}
+			d.started, d.array = false, false
+			continue
+		}
+		if d.err = d.start(); d.err != nil {
+			return false
+		}
+	}
+
+	// each element is decoded into a new value, so fields missing from it are not kept from the one before
+	d.elem = Element[T]{Index: d.next}
+	if err := d.dec.Decode(&d.elem.Value); err != nil {
+		var ute *json.UnmarshalTypeError
+		if !errors.As(err, &ute) {
+			d.err = err
+			return false
+		}
+		d.elem.Err = err
+		d.next++
+		return true
+	}
+	d.next++
+	d.elem.Err = d.tv.Validate(d.elem.Value)
+	return true
+}
+
+// Element returns the element decoded by the last call to Next.
+func (d *Decoder[T]) Element() Element[T] {
+	return d.elem
+}
+
+// Err returns the error that stopped Next, other than the end of the document. The failures of each element are
+// reported by its Err instead.
+func (d *Decoder[T]) Err() error {
+	if d.err == io.EOF {
+		return nil
+	}
+	return d.err
+}
+
+// start reads the start of the next value in the document, consuming the opening bracket if it is a JSON array. It
+// returns io.EOF if the document has ended.
+func (d *Decoder[T]) start() error {
+	d.started = true
+	if !d.dec.More() {
+		// the document has ended, unless a stray closing bracket follows, which Token reports
+		if _, err := d.dec.Token(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	// More has buffered the start of the value, so it can be looked at without being consumed
+	r := d.dec.Buffered()
+	var c [1]byte
+	for {
+		if _, err := r.Read(c[:]); err != nil {
+			return err
+		}
+		switch c[0] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		break
+	}
+	if c[0] != '[' {
+		return nil
+	}
+	// the bracket is read by the json.Decoder, so it expects commas between the elements
+	d.array = true
+	_, err := d.dec.Token()
+	return err
+}
+
+// Each calls fn with each element of the JSON array, or stream of JSON values, read from r, verified by tv as described
+// by NewDecoder. It stops at the end of the document, returning nil, or as soon as fn returns an error or the document
+// can not be decoded, returning the error.
+func Each[T any](r io.Reader, tv *verify.TypedValidator[T], fn func(Element[T]) error) error {
+	d := NewDecoder(r, tv)
+	for d.Next() {
+		if err := fn(d.Element()); err != nil {
+			return err
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,122 @@
+package stream_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+	"github.com/codyoss/verify/stream"
+)
+
+type row struct {
+	SKU   string `json:"sku" verify:"required"`
+	Count int    `json:"count" verify:"min=1"`
+}
+
+// outcome summarizes an element as its index and the codes of its failures, or TYPE if it did not match row.
+type outcome struct {
+	Index int
+	Codes []string
+}
+
+func outcomeOf(e stream.Element[row]) outcome {
+	o := outcome{Index: e.Index}
+	var ve *verify.ValidationError
+	var ute *json.UnmarshalTypeError
+	switch {
+	case errors.As(e.Err, &ve):
+		for _, fe := range ve.Errors {
+			o.Codes = append(o.Codes, fe.Code)
+		}
+	case errors.As(e.Err, &ute):
+		o.Codes = []string{"TYPE"}
+	}
+	return o
+}
+
+func TestDecoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []outcome
+		wantErr bool
+	}{
+		{"array", `[{"sku":"a","count":1}, {"count":0}, {"sku":"c","count":2}]`,
+			[]outcome{{0, nil}, {1, []string{"REQUIRED", "MIN"}}, {2, nil}}, false},
+		{"newline delimited", "{\"sku\":\"a\",\"count\":1}\n{\"sku\":\"b\"}\n",
+			[]outcome{{0, nil}, {1, []string{"MIN"}}}, false},
+		{"empty array", " \n[]", nil, false},
+		{"empty document", "", nil, false},
+		{"fields are not kept from the element before", `[{"sku":"a","count":1},{"count":1}]`,
+			[]outcome{{0, nil}, {1, []string{"REQUIRED"}}}, false},
+		{"element of the wrong type", `[{"sku":1,"count":1},{"sku":"b","count":1}]`,
+			[]outcome{{0, []string{"TYPE"}}, {1, nil}}, false},
+		{"array cut short", `[{"sku":"a","count":1}`, []outcome{{0, nil}}, true},
+		{"element cut short", `[{"sku":"a","count":1},{"sku"`, []outcome{{0, nil}}, true},
+		{"invalid document", `{"sku":"a","count":1} ]`, []outcome{{0, nil}}, true},
+		{"stray closing bracket", `[{"sku":"a","count":1}] ]`, []outcome{{0, nil}}, true},
+		{"newline delimited arrays", "[{\"sku\":\"a\",\"count\":1},{\"count\":1}]\n[]\n[{\"sku\":\"c\"}]\n",
+			[]outcome{{0, nil}, {1, []string{"REQUIRED"}}, {2, []string{"MIN"}}}, false},
+		{"arrays and values", "[{\"sku\":\"a\",\"count\":1}]\n{\"count\":1}\n",
+			[]outcome{{0, nil}, {1, []string{"REQUIRED"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []outcome
+			d := stream.NewDecoder[row](strings.NewReader(tt.input), nil)
+			for d.Next() {
+				got = append(got, outcomeOf(d.Element()))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+			if err := d.Err(); (err != nil) != tt.wantErr {
+				t.Errorf("want error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	type invalid struct {
+		A bool `verify:"min=1"`
+	}
+	d := stream.NewDecoder[invalid](strings.NewReader(`[{"A":true}]`), nil)
+	var ce *verify.ConfigError
+	if d.Next() || !errors.As(d.Err(), &ce) {
+		t.Errorf("expected invalid rules to stop the Decoder with a ConfigError, got %v", d.Err())
+	}
+
+	n := stream.NewDecoder[int](strings.NewReader("1\n2\n"), nil)
+	if n.Next() || n.Err() == nil {
+		t.Errorf("expected a non-struct type to stop the Decoder with an error, got %v", n.Err())
+	}
+}
+
+func TestEach(t *testing.T) {
+	input := `[{"sku":"a","count":1},{"count":1},{"sku":"c","count":1}]`
+	tv := verify.For[*row](verify.WithFieldNameTag("json"))
+
+	var fields []string
+	err := stream.Each(strings.NewReader(input), tv, func(e stream.Element[*row]) error {
+		var ve *verify.ValidationError
+		if errors.As(e.Err, &ve) {
+			fields = append(fields, ve.Errors[0].Field)
+		}
+		return nil
+	})
+	if err != nil || !reflect.DeepEqual(fields, []string{"sku"}) {
+		t.Errorf("want the failure of sku and no error, got %v and %v", fields, err)
+	}
+
+	stop := errors.New("stop")
+	var n int
+	err = stream.Each(strings.NewReader(input), tv, func(e stream.Element[*row]) error {
+		n++
+		return stop
+	})
+	if err != stop || n != 1 {
+		t.Errorf("expected the error of fn to stop Each after 1 element, got %v after %d", err, n)
+	}
+}
@@ -0,0 +1,78 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// StructLevel is passed to a struct validation function registered with RegisterStructValidation.
+type StructLevel struct {
+	// Value is the struct being verified.
+	Value reflect.Value
+
+	ctx  context.Context
+	errs []*FieldError
+	// vd is the Validator verifying the struct, which determines the names fields are reported by.
+	vd *Validator
+	// loc is the location of the struct.
+	loc location
+}
+
+// Context returns the context the struct is being verified with. It is the context passed to ItContext, or
+// context.Background if verification was started without one.
+func (sl *StructLevel) Context() context.Context {
+	return sl.ctx
+}
+
+// ReportError reports that field, the Go name of a field of the struct, failed the rule tag because of err. The
+// FieldError reported wraps err, and its Field is the path of the field if the struct is nested within the one being
+// verified, named in the same way as the fields verified by tags.
+func (sl *StructLevel) ReportError(field, tag string, err error) {
+	name := field
+	if sf, ok := sl.Value.Type().FieldByName(field); ok {
+		name = sl.vd.fieldName(sf)
+	}
+	path, pointer := sl.loc.fieldPath(name)
+	fe := &FieldError{
+		Field:   path,
+		Tag:     tag,
+		Code:    tagCode(tag),
+		Pointer: pointer,
+		msg:     fmt.Sprintf("%s failed %s: %v", path, tag, err),
+		err:     err,
+	}
+	if f := sl.Value.FieldByName(field); f.IsValid() {
+		fe.Value = interfaceOf(f)
+	}
+	sl.errs = append(sl.errs, fe)
+}
+
+// RegisterStructValidation registers fn as a struct validation function for each of the types of the provided values
+// on the default Validator used by the package level functions. See Validator.RegisterStructValidation for details.
+func RegisterStructValidation(fn func(*StructLevel), types ...interface{}) {
+	defaultValidator.RegisterStructValidation(fn, types...)
+}
+
+// RegisterStructValidation registers fn as a struct validation function for each of the struct types of the provided
+// values. fn is called after the field rules of such a struct have been verified, wherever the struct is verified, and
+// may report errors spanning multiple fields, such as a start date that must be before an end date. Registering a type
+// again replaces its function.
+func (vd *Validator) RegisterStructValidation(fn func(*StructLevel), types ...interface{}) {
+	vd.mu.Lock()
+	defer vd.mu.Unlock()
+	if vd.structFuncs == nil {
+		vd.structFuncs = make(map[reflect.Type]func(*StructLevel))
+	}
+	for _, t := range types {
+		vd.structFuncs[derefType(reflect.TypeOf(t))] = fn
+	}
+	vd.clearCache()
+}
+
+// lookupStructFunc returns the struct validation function registered for t, if there is one.
+func (vd *Validator) lookupStructFunc(t reflect.Type) func(*StructLevel) {
+	vd.mu.RLock()
+	defer vd.mu.RUnlock()
+	return vd.structFuncs[t]
+}
@@ -0,0 +1,73 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codyoss/verify"
+)
+
+type booking struct {
+	Start time.Time
+	End   time.Time
+	Guest string `verify:"required"`
+}
+
+var errEndBeforeStart = errors.New("must be after Start")
+
+func verifyBooking(sl *verify.StructLevel) {
+	b := sl.Value.Interface().(booking)
+	if !b.End.After(b.Start) {
+		sl.ReportError("End", "afterStart", errEndBeforeStart)
+	}
+}
+
+func TestRegisterStructValidation(t *testing.T) {
+	type A struct {
+		Bookings []booking `verify:"dive"`
+	}
+
+	now := time.Now()
+	v := verify.New()
+	v.RegisterStructValidation(verifyBooking, booking{})
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		wantCount int
+	}{
+		{"struct fails", booking{Start: now, End: now, Guest: "a"}, 1},
+		{"struct and field fail", booking{Start: now, End: now}, 2},
+		{"works", booking{Start: now, End: now.Add(time.Hour), Guest: "a"}, 0},
+		{"element fails", A{[]booking{{Start: now, End: now, Guest: "a"}}}, 1},
+		{"works elements", A{[]booking{{Start: now, End: now.Add(time.Hour), Guest: "a"}}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.It(tt.input)
+			got := 0
+			var ve *verify.ValidationError
+			if errors.As(err, &ve) {
+				got = len(ve.Errors)
+			}
+			if got != tt.wantCount {
+				t.Errorf("want %d errors, got %v", tt.wantCount, err)
+			}
+		})
+	}
+
+	var fe *verify.FieldError
+	err := v.It(booking{Start: now, End: now, Guest: "a"})
+	if !errors.As(err, &fe) || fe.Field != "End" || fe.Tag != "afterStart" || fe.Value != now ||
+		!errors.Is(err, errEndBeforeStart) {
+		t.Errorf("expected a FieldError for End wrapping the reported error, got %+v", fe)
+	}
+
+	pv := verify.New(verify.WithJSONPointer())
+	pv.RegisterStructValidation(verifyBooking, booking{})
+	err = pv.It(A{[]booking{{Start: now, End: now.Add(time.Hour), Guest: "a"}, {Start: now, End: now, Guest: "a"}}})
+	if !errors.As(err, &fe) || fe.Field != "Bookings[1].End" || fe.Pointer != "/Bookings/1/End" {
+		t.Errorf("expected the FieldError of an element to be reported by its path, got %+v", fe)
+	}
+}
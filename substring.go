@@ -0,0 +1,55 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	tagStartsWith  = "startswith"
+	tagEndsWith    = "endswith"
+	tagContains    = "contains"
+	tagExcludes    = "excludes"
+	tagExcludesAll = "excludesall"
+)
+
+// The errors below are wrapped by each FieldError for a failed substring sub-tag.
+var (
+	ErrStartsWith  = errors.New("verify: field does not start with the value specified by startswith")
+	ErrEndsWith    = errors.New("verify: field does not end with the value specified by endswith")
+	ErrContains    = errors.New("verify: field does not contain the value specified by contains")
+	ErrExcludes    = errors.New("verify: field contains the value specified by excludes")
+	ErrExcludesAll = errors.New("verify: field contains a character specified by excludesall")
+)
+
+func init() {
+	builtinRules[tagStartsWith] = derefRule(substring(ErrStartsWith, "%s must start with %q", strings.HasPrefix))
+	builtinRules[tagEndsWith] = derefRule(substring(ErrEndsWith, "%s must end with %q", strings.HasSuffix))
+	builtinRules[tagContains] = derefRule(substring(ErrContains, "%s must contain %q", strings.Contains))
+	builtinRules[tagExcludes] = derefRule(substring(ErrExcludes, "%s must not contain %q",
+		func(s, sub string) bool { return !strings.Contains(s, sub) }))
+	builtinRules[tagExcludesAll] = derefRule(substring(ErrExcludesAll, "%s must not contain any of the characters %q",
+		func(s, chars string) bool { return !strings.ContainsAny(s, chars) }))
+}
+
+// substring returns a ruleBuilder for a sub-tag that checks a string field against the tag's value. pass reports
+// whether the field s passes given the value, and format describes the failure given the field name and the value.
+func substring(sentinel error, format string, pass func(s, param string) bool) ruleBuilder {
+	return func(s ruleSpec) (*rule, error) {
+		if !s.hasParam || s.param == "" {
+			return nil, fmt.Errorf("%s must specify a value", s.tag)
+		}
+		if s.typ.Kind() != reflect.String {
+			return nil, fmt.Errorf("%s can only be used with types: string", s.tag)
+		}
+		return &rule{
+			tag:   s.tag,
+			param: s.param,
+			err:   sentinel,
+			check: func(f reflect.Value) bool { return pass(f.String(), s.param) },
+			msg:   func(name string) string { return fmt.Sprintf(format, name, s.param) },
+		}, nil
+	}
+}
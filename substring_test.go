@@ -0,0 +1,59 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestSubstring(t *testing.T) {
+	type A struct {
+		Key     string  `verify:"startswith=sk_"`
+		File    *string `verify:"endswith=.csv"`
+		Scope   string  `verify:"contains=:"`
+		Name    string  `verify:"excludes=admin"`
+		Comment string  `verify:"excludesall='<>,'"`
+	}
+
+	csv, txt := "data.csv", "data.txt"
+	valid := A{Key: "sk_123", File: &csv, Scope: "read:users", Name: "gopher", Comment: "hello"}
+	tests := []struct {
+		name    string
+		modify  func(a *A)
+		wantErr error
+	}{
+		{"works", func(a *A) {}, nil},
+		{"works nil pointer", func(a *A) { a.File = nil }, nil},
+		{"missing prefix", func(a *A) { a.Key = "pk_123" }, verify.ErrStartsWith},
+		{"missing suffix", func(a *A) { a.File = &txt }, verify.ErrEndsWith},
+		{"missing substring", func(a *A) { a.Scope = "read" }, verify.ErrContains},
+		{"excluded substring", func(a *A) { a.Name = "sysadmin" }, verify.ErrExcludes},
+		{"excluded character", func(a *A) { a.Comment = "<b>" }, verify.ErrExcludesAll},
+		{"excluded quoted comma", func(a *A) { a.Comment = "a, b" }, verify.ErrExcludesAll},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := valid
+			tt.modify(&a)
+			got := verify.It(a)
+			if (tt.wantErr == nil && got != nil) || (tt.wantErr != nil && !errors.Is(got, tt.wantErr)) {
+				t.Errorf("want %v, got %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestSubstringConfig(t *testing.T) {
+	type A struct {
+		A string `verify:"startswith"`
+	}
+	type B struct {
+		A int `verify:"contains=1"`
+	}
+	for _, v := range []interface{}{A{}, B{}} {
+		if err := verify.Check(v); err == nil {
+			t.Errorf("Check(%T) want an error, got nil", v)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+const (
+	tagBefore = "before"
+	tagAfter  = "after"
+
+	// timeNow is the value of a before or after sub-tag that compares against the time the field is verified.
+	timeNow = "now"
+)
+
+// The errors below are wrapped by each FieldError for a failed before or after sub-tag.
+var (
+	ErrBefore = errors.New("verify: field is not before the time specified by before")
+	ErrAfter  = errors.New("verify: field is not after the time specified by after")
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func init() {
+	builtinRules[tagBefore] = derefRule(timeComparison(ErrBefore, "before",
+		func(f, t time.Time) bool { return f.Before(t) }))
+	builtinRules[tagAfter] = derefRule(timeComparison(ErrAfter, "after",
+		func(f, t time.Time) bool { return f.After(t) }))
+}
+
+// timeComparison returns a ruleBuilder for a sub-tag that compares a time.Time field against a time given as an
+// RFC 3339 string, or the keyword now. pass reports whether the field f passes when compared to the time t.
+func timeComparison(sentinel error, word string, pass func(f, t time.Time) bool) ruleBuilder {
+	return func(s ruleSpec) (*rule, error) {
+		if !s.hasParam || s.param == "" {
+			return nil, fmt.Errorf("%s must specify a time", s.tag)
+		}
+		if s.typ != timeType {
+			return nil, fmt.Errorf("%s can only be used with types: time.Time", s.tag)
+		}
+		r := &rule{
+			tag:   s.tag,
+			param: s.param,
+			err:   sentinel,
+			msg:   func(name string) string { return fmt.Sprintf("%s must be %s %s", name, word, s.param) },
+		}
+		if s.param == timeNow {
+			r.check = func(f reflect.Value) bool { return pass(readable(f).Interface().(time.Time), time.Now()) }
+			return r, nil
+		}
+		t, err := time.Parse(time.RFC3339, s.param)
+		if err != nil {
+			return nil, fmt.Errorf("%s value %q must be an RFC 3339 time or %s", s.tag, s.param, timeNow)
+		}
+		r.check = func(f reflect.Value) bool { return pass(readable(f).Interface().(time.Time), t) }
+		return r, nil
+	}
+}
@@ -0,0 +1,141 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/codyoss/verify"
+)
+
+func TestTime(t *testing.T) {
+	type A struct {
+		Start time.Time  `verify:"required,after=2020-01-01T00:00:00Z,before=now"`
+		End   *time.Time `verify:"after=now"`
+	}
+
+	past := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name    string
+		input   A
+		wantErr error
+	}{
+		{"works", A{Start: past, End: &future}, nil},
+		{"works nil pointer", A{Start: past}, nil},
+		{"zero", A{}, verify.ErrRequired},
+		{"zero in another location", A{Start: time.Time{}.In(time.FixedZone("", 3600))}, verify.ErrRequired},
+		{"not after", A{Start: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)}, verify.ErrAfter},
+		{"not before now", A{Start: future}, verify.ErrBefore},
+		{"pointer not after now", A{Start: past, End: &past}, verify.ErrAfter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr == nil && got != nil) || (tt.wantErr != nil && !errors.Is(got, tt.wantErr)) {
+				t.Errorf("want %v, got %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestTimeUnexported(t *testing.T) {
+	type A struct {
+		start time.Time  `verify:"required,after=2020-01-01T00:00:00Z,before=now"`
+		end   *time.Time `verify:"after=now"`
+	}
+
+	past := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name    string
+		input   A
+		wantErr error
+	}{
+		{"works", A{start: past, end: &future}, nil},
+		{"zero", A{}, verify.ErrRequired},
+		{"not after", A{start: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)}, verify.ErrAfter},
+		{"not before now", A{start: future}, verify.ErrBefore},
+		{"pointer not after now", A{start: past, end: &past}, verify.ErrAfter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// unexported fields are verified whether the struct is passed by value or through a pointer
+			for _, v := range []interface{}{tt.input, &tt.input} {
+				got := verify.It(v)
+				if (tt.wantErr == nil && got != nil) || (tt.wantErr != nil && !errors.Is(got, tt.wantErr)) {
+					t.Errorf("want %v, got %v", tt.wantErr, got)
+				}
+			}
+		})
+	}
+}
+
+func TestTimeConfig(t *testing.T) {
+	type A struct {
+		A time.Time `verify:"before"`
+	}
+	type B struct {
+		A time.Time `verify:"after=yesterday"`
+	}
+	type C struct {
+		A string `verify:"before=now"`
+	}
+	for _, v := range []interface{}{A{}, B{}, C{}} {
+		if err := verify.Check(v); err == nil {
+			t.Errorf("Check(%T) want an error, got nil", v)
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	type A struct {
+		Timeout time.Duration  `verify:"min=1s,max=5m"`
+		Retry   *time.Duration `verify:"gt=0s"`
+		Window  time.Duration  `verify:"between=1m:1h"`
+	}
+
+	zero, second := time.Duration(0), time.Second
+	tests := []struct {
+		name    string
+		input   A
+		wantErr error
+	}{
+		{"works", A{Timeout: time.Second, Retry: &second, Window: time.Hour}, nil},
+		{"works nil pointer", A{Timeout: 5 * time.Minute, Window: time.Minute}, nil},
+		{"too short", A{Timeout: time.Millisecond, Window: time.Minute}, verify.ErrMin},
+		{"too long", A{Timeout: time.Hour, Window: time.Minute}, verify.ErrMax},
+		{"pointer not greater", A{Timeout: time.Second, Retry: &zero, Window: time.Minute}, verify.ErrGt},
+		{"outside range", A{Timeout: time.Second, Window: time.Second}, verify.ErrBetween},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr == nil && got != nil) || (tt.wantErr != nil && !errors.Is(got, tt.wantErr)) {
+				t.Errorf("want %v, got %v", tt.wantErr, got)
+			}
+		})
+	}
+
+	err := verify.It(A{Timeout: time.Hour, Window: time.Minute})
+	if want := "verify found the following errors: [Timeout has value greater than max 5m0s]"; err == nil || err.Error() != want {
+		t.Errorf("got %v, want %q", err, want)
+	}
+}
+
+func TestDurationConfig(t *testing.T) {
+	type A struct {
+		A time.Duration `verify:"min=1"`
+	}
+	type B struct {
+		A time.Duration `verify:"between=1m:1x"`
+	}
+	type C struct {
+		A time.Duration `verify:"between=1h:1m"`
+	}
+	for _, v := range []interface{}{A{}, B{}, C{}} {
+		if err := verify.Check(v); err == nil {
+			t.Errorf("Check(%T) want an error, got nil", v)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// pluralCategories names each plural form as used in the suffix of a catalog key.
+var pluralCategories = map[plural.Form]string{
+	plural.Other: "other",
+	plural.Zero:  "zero",
+	plural.One:   "one",
+	plural.Two:   "two",
+	plural.Few:   "few",
+	plural.Many:  "many",
+}
+
+// RegisterCatalog registers messages as the catalog for locale on the default Validator used by the package level
+// functions. See Validator.RegisterCatalog for details.
+func RegisterCatalog(locale string, messages map[string]string) {
+	defaultValidator.RegisterCatalog(locale, messages)
+}
+
+// Translate renders the failures held by err in locale using the default Validator used by the package level
+// functions. See Validator.Translate for details.
+func Translate(err error, locale string) error {
+	return defaultValidator.Translate(err, locale)
+}
+
+// RegisterCatalog registers messages, a template for each sub-tag keyed by its name, as the catalog used by Translate
+// for locale, such as "fr" or "pt-BR". Templates use the same placeholders as RegisterMessage, for example
+// {"required": "{field} est obligatoire"}.
+//
+// A sub-tag whose param is a number, such as minSize, may have a template for each CLDR plural category of the locale,
+// keyed by the name of the sub-tag followed by a dot and the category: zero, one, two, few, many, or other. The category
+// is chosen by the plural rules of the locale for the param, falling back to the other category and then to the key
+// without a category, for example:
+//
+//	vd.RegisterCatalog("en", map[string]string{
+//		"minSize.one":   "{field} must contain at least {param} item",
+//		"minSize.other": "{field} must contain at least {param} items",
+//	})
+//
+// Registering a locale again replaces its catalog. RegisterCatalog panics if locale is empty or a template contains an
+// unknown placeholder.
+func (vd *Validator) RegisterCatalog(locale string, messages map[string]string) {
+	if locale == "" {
+		panic("verify: invalid catalog locale \"\"")
+	}
+	catalog := make(map[string]string, len(messages))
+	for tag, template := range messages {
+		if p, ok := unknownPlaceholder(template); ok {
+			panic(fmt.Sprintf("verify: %s message for %q has unknown placeholder %s", locale, tag, p))
+		}
+		catalog[tag] = template
+	}
+
+	vd.mu.Lock()
+	defer vd.mu.Unlock()
+	if vd.catalogs == nil {
+		vd.catalogs = make(map[string]map[string]string)
+	}
+	vd.catalogs[strings.ToLower(locale)] = catalog
+}
+
+// Translate returns a copy of the *ValidationError held by err whose FieldErrors have their messages rendered from
+// the catalog registered for locale. A locale with a region, such as fr-CA, falls back to the catalog of its language
+// if it has none of its own, and failures of sub-tags the catalog does not cover keep their original message, as do
+// failures of fields whose message is set by the msg sub-tag. If err does not hold a *ValidationError it is returned
+// unchanged.
+func (vd *Validator) Translate(err error, locale string) error {
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		return err
+	}
+	catalog := vd.lookupCatalog(locale)
+	if catalog == nil {
+		return ve
+	}
+
+	tag := language.Make(locale)
+	translated := &ValidationError{Errors: make([]*FieldError, len(ve.Errors)), Truncated: ve.Truncated}
+	for i, fe := range ve.Errors {
+		template, ok := lookupTemplate(catalog, fe.Tag, pluralCategory(tag, fe.Param))
+		if !ok || fe.custom {
+			translated.Errors[i] = fe
+			continue
+		}
+		tfe := *fe
+		tfe.msg, tfe.valueLen = renderMessage(template, fe.Field, fe.Param, fe.Value), 0
+		translated.Errors[i] = &tfe
+	}
+	return translated
+}
+
+// lookupCatalog returns the catalog registered for locale, or for its language if there is none for the locale itself.
+func (vd *Validator) lookupCatalog(locale string) map[string]string {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+
+	vd.mu.RLock()
+	defer vd.mu.RUnlock()
+	if catalog, ok := vd.catalogs[locale]; ok {
+		return catalog
+	}
+	if lang, _, ok := strings.Cut(locale, "-"); ok {
+		return vd.catalogs[lang]
+	}
+	return nil
+}
+
+// lookupTemplate returns the template in catalog for the sub-tag tag with a param in the plural category, falling back
+// to the other category and then to the template for tag itself.
+func lookupTemplate(catalog map[string]string, tag, category string) (string, bool) {
+	if template, ok := catalog[tag+"."+category]; ok {
+		return template, true
+	}
+	if template, ok := catalog[tag+".other"]; ok {
+		return template, true
+	}
+	template, ok := catalog[tag]
+	return template, ok
+}
+
+// pluralCategory returns the plural category of param, a decimal number, in lang. A param that is not a number is in the
+// other category.
+func pluralCategory(lang language.Tag, param string) string {
+	param = strings.TrimPrefix(param, "-")
+	intPart, fracPart, _ := strings.Cut(param, ".")
+	i, err := strconv.Atoi(intPart)
+	if err != nil {
+		return pluralCategories[plural.Other]
+	}
+	// The operands are as defined by CLDR: v and f are the count and value of the fraction digits, and w and t are
+	// the same without trailing zeros.
+	var v, f, w, t int
+	if fracPart != "" {
+		if f, err = strconv.Atoi(fracPart); err != nil || f < 0 {
+			return pluralCategories[plural.Other]
+		}
+		v = len(fracPart)
+		trimmed := strings.TrimRight(fracPart, "0")
+		w = len(trimmed)
+		t, _ = strconv.Atoi(trimmed)
+	}
+	return pluralCategories[plural.Cardinal.MatchPlural(lang, i, v, w, f, t)]
+}
@@ -0,0 +1,166 @@
+package verify_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestTranslate(t *testing.T) {
+	vd := verify.New()
+	vd.RegisterCatalog("fr", map[string]string{
+		"required": "{field} est obligatoire",
+		"minSize":  "{field} doit contenir au moins {param} caractères",
+	})
+	vd.RegisterCatalog("fr-CA", map[string]string{
+		"required": "{field} est requis",
+	})
+
+	type A struct {
+		A string `verify:"required"`
+		B string `verify:"minSize=3"`
+		C int    `verify:"max=1"`
+	}
+	err := vd.It(A{B: "ab", C: 2})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	tests := []struct {
+		name   string
+		locale string
+		want   []string
+	}{
+		{"language", "fr", []string{
+			"A est obligatoire", "B doit contenir au moins 3 caractères", "C has value greater than max 1",
+		}},
+		{"region", "fr-CA", []string{"A est requis", "B has a length less than 3", "C has value greater than max 1"}},
+		{"region falls back to language", "fr_BE", []string{
+			"A est obligatoire", "B doit contenir au moins 3 caractères", "C has value greater than max 1",
+		}},
+		{"case insensitive", "FR", []string{
+			"A est obligatoire", "B doit contenir au moins 3 caractères", "C has value greater than max 1",
+		}},
+		{"unknown locale", "de", []string{
+			"A is required but is set to zero value", "B has a length less than 3", "C has value greater than max 1",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ve *verify.ValidationError
+			if !errors.As(vd.Translate(err, tt.locale), &ve) {
+				t.Fatal("expected a *verify.ValidationError")
+			}
+			if len(ve.Errors) != len(tt.want) {
+				t.Fatalf("expected %d errors, got %d", len(tt.want), len(ve.Errors))
+			}
+			for i, w := range tt.want {
+				if got := ve.Errors[i].Error(); got != w {
+					t.Errorf("error %d: want %q, got %q", i, w, got)
+				}
+			}
+			if !errors.Is(ve, verify.ErrRequired) {
+				t.Error("expected the sentinel errors to be unchanged")
+			}
+		})
+	}
+
+	if got := err.Error(); got != "verify found the following errors: [A is required but is set to zero value, "+
+		"B has a length less than 3, C has value greater than max 1]" {
+		t.Errorf("expected the original error to be unchanged, got %q", got)
+	}
+	other := errors.New("decode failed")
+	if got := vd.Translate(other, "fr"); got != other {
+		t.Errorf("expected other errors to be returned unchanged, got %v", got)
+	}
+
+	type B struct {
+		A string `verify:"required,msg=must be given"`
+		B string `verify:"required"`
+	}
+	var ve *verify.ValidationError
+	if !errors.As(vd.Translate(vd.It(B{}), "fr"), &ve) || len(ve.Errors) != 2 {
+		t.Fatal("expected a *verify.ValidationError with 2 errors")
+	}
+	if got := ve.Errors[0].Error(); got != "A must be given" {
+		t.Errorf("expected the message of the msg sub-tag to be kept, got %q", got)
+	}
+	if got := ve.Errors[1].Error(); got != "B est obligatoire" {
+		t.Errorf("expected the field without a msg sub-tag to be translated, got %q", got)
+	}
+}
+
+func TestTranslatePlural(t *testing.T) {
+	vd := verify.New()
+	vd.RegisterCatalog("en", map[string]string{
+		"minSize.one":   "{field} must contain at least {param} item",
+		"minSize.other": "{field} must contain at least {param} items",
+		"max":           "{field} must be at most {param}",
+	})
+	vd.RegisterCatalog("ru", map[string]string{
+		"minSize.one":  "{field}: минимум {param} элемент",
+		"minSize.few":  "{field}: минимум {param} элемента",
+		"minSize.many": "{field}: минимум {param} элементов",
+	})
+	vd.RegisterCatalog("fr", map[string]string{
+		"minSize.other": "{field} doit contenir au moins {param} éléments",
+	})
+
+	type A struct {
+		A []int   `verify:"minSize=1"`
+		B []int   `verify:"minSize=3"`
+		C []int   `verify:"minSize=5"`
+		D []int   `verify:"minSize=21"`
+		E float64 `verify:"max=1.5"`
+	}
+	err := vd.It(A{E: 2})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	tests := []struct {
+		name   string
+		locale string
+		want   []string
+	}{
+		{"en", "en-US", []string{
+			"A must contain at least 1 item", "B must contain at least 3 items", "C must contain at least 5 items",
+			"D must contain at least 21 items", "E must be at most 1.5",
+		}},
+		{"ru", "ru", []string{
+			"A: минимум 1 элемент", "B: минимум 3 элемента", "C: минимум 5 элементов", "D: минимум 21 элемент",
+			"E has value greater than max 1.500000",
+		}},
+		{"falls back to other", "fr", []string{
+			"A doit contenir au moins 1 éléments", "B doit contenir au moins 3 éléments",
+			"C doit contenir au moins 5 éléments", "D doit contenir au moins 21 éléments",
+			"E has value greater than max 1.500000",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ve *verify.ValidationError
+			if !errors.As(vd.Translate(err, tt.locale), &ve) {
+				t.Fatal("expected a *verify.ValidationError")
+			}
+			if len(ve.Errors) != len(tt.want) {
+				t.Fatalf("expected %d errors, got %d", len(tt.want), len(ve.Errors))
+			}
+			for i, w := range tt.want {
+				if got := ve.Errors[i].Error(); got != w {
+					t.Errorf("error %d: want %q, got %q", i, w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRegisterCatalogInvalid(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected RegisterCatalog to panic")
+		}
+	}()
+	verify.New().RegisterCatalog("fr", map[string]string{"min": "{champ} est trop petit"})
+}
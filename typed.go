@@ -0,0 +1,49 @@
+package verify
+
+import (
+	"context"
+	"reflect"
+)
+
+// TypedValidator verifies values of type T, which must be a struct or a pointer to a struct. The rules of T are
+// compiled once, when the TypedValidator is created.
+type TypedValidator[T any] struct {
+	c   *Compiled
+	ptr bool
+	err error
+}
+
+// For compiles the rules of T and returns a TypedValidator for it. With no options the rules are compiled by the
+// default Validator used by the package level functions, otherwise by a new Validator configured with opts. Any error
+// in the struct field tags of T is returned by every call to Validate.
+func For[T any](opts ...Option) *TypedValidator[T] {
+	vd := defaultValidator
+	if len(opts) > 0 {
+		vd = New(opts...)
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	c, err := vd.Compile(t)
+	return &TypedValidator[T]{c: c, ptr: t.Kind() == reflect.Ptr, err: err}
+}
+
+// Err returns the error compiling the rules of T, which is returned by every call to Validate, or nil if they are valid.
+func (tv *TypedValidator[T]) Err() error {
+	return tv.err
+}
+
+// Validate verifies v in the same way as the package level It, without parsing any struct field tags.
+func (tv *TypedValidator[T]) Validate(v T) error {
+	if tv.err != nil {
+		return tv.err
+	}
+	rv := reflect.ValueOf(&v).Elem()
+	if tv.ptr {
+		if rv.IsNil() {
+			return errInvalidKind
+		}
+		rv = rv.Elem()
+	}
+	st := newState(context.Background())
+	defer putState(st)
+	return tv.c.vd.verify(st, tv.c.rules, rv).Err
+}
@@ -0,0 +1,69 @@
+package verify_test
+
+import (
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestFor(t *testing.T) {
+	type A struct {
+		A string `verify:"minSize=2"`
+	}
+	type B struct {
+		A bool `verify:"min=abc"`
+	}
+
+	if tv := verify.For[int](); tv.Err() == nil || tv.Validate(1) != tv.Err() {
+		t.Error("expected an error for a non-struct type")
+	}
+	if tv := verify.For[B](); tv.Err() == nil || tv.Validate(B{}) != tv.Err() {
+		t.Error("expected an error for invalid tags")
+	}
+	if err := verify.For[A]().Err(); err != nil {
+		t.Errorf("expected valid rules to compile, got %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		input   A
+		wantErr bool
+	}{
+		{"field fails", A{"a"}, true},
+		{"works", A{"ab"}, false},
+	}
+	tv := verify.For[A]()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tv.Validate(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestForPointer(t *testing.T) {
+	type A struct {
+		A string `verify:"minSize=2"`
+	}
+
+	tests := []struct {
+		name    string
+		input   *A
+		wantErr bool
+	}{
+		{"nil input", nil, true},
+		{"field fails", &A{"a"}, true},
+		{"works", &A{"ab"}, false},
+	}
+	tv := verify.For[*A](verify.WithFailMode(verify.FailFirstField))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tv.Validate(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package verify
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TypeFunc converts a value of a registered type into the value its sub-tags are verified against. This gives
+// sub-tags like required, min, and max meaningful semantics for types whose zero value or ordering is not captured by
+// their kind, such as a decimal type converted to a float64. A TypeFunc must return values of a single type, including
+// for the zero value of the registered type, and must not return nil.
+type TypeFunc func(v reflect.Value) interface{}
+
+// RegisterTypeFunc registers fn to convert fields of each of the types of the provided values on the default
+// Validator used by the package level functions. See Validator.RegisterTypeFunc for details.
+func RegisterTypeFunc(fn TypeFunc, types ...interface{}) {
+	defaultValidator.RegisterTypeFunc(fn, types...)
+}
+
+// RegisterTypeFunc registers fn to convert fields of each of the types of the provided values, for example
+// time.Time{}, before they are verified. The sub-tags of such a field are verified against the value returned by fn
+// rather than the field itself, though any FieldError still reports the field's own value. Registering a type again
+// replaces its function.
+func (vd *Validator) RegisterTypeFunc(fn TypeFunc, types ...interface{}) {
+	vd.mu.Lock()
+	defer vd.mu.Unlock()
+	if vd.typeFuncs == nil {
+		vd.typeFuncs = make(map[reflect.Type]TypeFunc)
+	}
+	for _, t := range types {
+		vd.typeFuncs[reflect.TypeOf(t)] = fn
+	}
+	vd.clearCache()
+}
+
+// lookupTypeFunc returns the TypeFunc registered for t, if there is one.
+func (vd *Validator) lookupTypeFunc(t reflect.Type) (TypeFunc, bool) {
+	vd.mu.RLock()
+	defer vd.mu.RUnlock()
+	fn, ok := vd.typeFuncs[t]
+	return fn, ok
+}
+
+// convertedType returns the type fn converts values of t into.
+func convertedType(name string, t reflect.Type, fn TypeFunc) (reflect.Type, error) {
+	ct := reflect.TypeOf(fn(reflect.Zero(t)))
+	if ct == nil {
+		return nil, fmt.Errorf("%s type %s is registered with a TypeFunc that returned nil", name, t)
+	}
+	return ct, nil
+}
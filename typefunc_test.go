@@ -0,0 +1,77 @@
+package verify_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/codyoss/verify"
+)
+
+type money struct {
+	Units int64
+	Cents int64
+}
+
+func moneyToFloat(v reflect.Value) interface{} {
+	m := v.Interface().(money)
+	return float64(m.Units) + float64(m.Cents)/100
+}
+
+func TestRegisterTypeFunc(t *testing.T) {
+	type A struct {
+		A money       `verify:"required,min=0.5,max=10.0"`
+		B []time.Time `verify:"dive,required"`
+	}
+
+	v := verify.New()
+	if err := v.It(A{}); err == nil {
+		t.Fatal("expected min to be invalid on a struct before its type is registered")
+	}
+	v.RegisterTypeFunc(moneyToFloat, money{})
+	v.RegisterTypeFunc(func(v reflect.Value) interface{} {
+		if t := v.Interface().(time.Time); !t.IsZero() {
+			return t.UnixNano()
+		}
+		return int64(0)
+	}, time.Time{})
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"zero value fails", A{}, true},
+		{"too small", A{A: money{Cents: 49}}, true},
+		{"too large", A{A: money{Units: 10, Cents: 1}}, true},
+		{"zero element fails", A{A: money{Units: 1}, B: []time.Time{{}}}, true},
+		{"works", A{A: money{Units: 1}, B: []time.Time{time.Now()}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+
+	var fe *verify.FieldError
+	err := v.It(A{A: money{Units: 11}})
+	if !errors.As(err, &fe) || fe.Value != (money{Units: 11}) {
+		t.Errorf("expected the FieldError to report the field's own value, got %v", fe)
+	}
+}
+
+func TestRegisterTypeFuncNil(t *testing.T) {
+	type A struct {
+		A money `verify:"required"`
+	}
+
+	v := verify.New()
+	v.RegisterTypeFunc(func(reflect.Value) interface{} { return nil }, money{})
+	if err := v.Check(A{}); err == nil {
+		t.Error("expected a TypeFunc returning nil to be reported")
+	}
+}
@@ -0,0 +1,154 @@
+package verify
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// WithUnsafeFieldAccess makes the Validator read the fields of structs whose types are strings, bools, or numbers
+// directly from memory at offsets computed when the struct type is compiled, rather than through reflect, for
+// latency-critical services. It applies to the sub-tags required, minSize, maxSize, len, min, max, gt, gte, lt, and lte;
+// fields using any other sub-tag, and fields that fail, are verified through reflect as usual, so the errors reported
+// are the same. Fields can only be read in place if the struct is addressable, so pass a pointer to the struct rather
+// than the struct itself.
+func WithUnsafeFieldAccess() Option {
+	return func(vd *Validator) {
+		vd.unsafeAccess = true
+	}
+}
+
+// fastCheck reports whether the value at p passes a rule, reading it directly rather than through reflect.
+type fastCheck func(p unsafe.Pointer) bool
+
+// fieldBase returns the address of rv, the struct st is verifying, if its fields should be read with their fast
+// checks, or nil if they should be verified through reflect. Fields are read through reflect while recording outcomes,
+// so every rule that passes is recorded.
+func (vd *Validator) fieldBase(st *state, rv reflect.Value) unsafe.Pointer {
+	if !vd.unsafeAccess || st.record || !rv.CanAddr() {
+		return nil
+	}
+	return unsafe.Pointer(rv.UnsafeAddr())
+}
+
+// fastField returns a fastCheck reporting whether a field of type t passes every rule of vr, honoring omitempty, or
+// nil if any of them can not be verified without reflect.
+func fastField(vr *valueRules, t reflect.Type) fastCheck {
+	if vr.conv != nil || vr.elem != nil || vr.strct != nil || vr.verifier || len(vr.rules) == 0 {
+		return nil
+	}
+	checks := make([]fastCheck, len(vr.rules))
+	for i, r := range vr.rules {
+		if r.fast == nil {
+			return nil
+		}
+		checks[i] = r.fast
+	}
+	var zero fastCheck
+	if vr.omitempty {
+		if zero = zeroCheck(t); zero == nil {
+			return nil
+		}
+	}
+	omitFrom := vr.omitFrom
+	return func(p unsafe.Pointer) bool {
+		checks := checks
+		if zero != nil && zero(p) {
+			checks = checks[:omitFrom]
+		}
+		for _, check := range checks {
+			if !check(p) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// zeroCheck returns a fastCheck reporting whether a value of type t is its zero value. It returns nil for floats, as
+// whether reflect.Value.IsZero reports -0 as zero depends on the version of Go.
+func zeroCheck(t reflect.Type) fastCheck {
+	switch t.Kind() {
+	case reflect.String:
+		return stringCheck(t, func(v string) bool { return v == "" })
+	case reflect.Bool:
+		return func(p unsafe.Pointer) bool { return !*(*bool)(p) }
+	}
+	if check := intCheck(t, func(v int64) bool { return v == 0 }); check != nil {
+		return check
+	}
+	return uintCheck(t, func(v uint64) bool { return v == 0 })
+}
+
+// requiredCheck returns the fastCheck of required for a value of type t, or nil if t is not a string, bool, or number.
+func requiredCheck(t reflect.Type) fastCheck {
+	switch t.Kind() {
+	case reflect.String:
+		return stringCheck(t, func(v string) bool { return v != "" })
+	case reflect.Bool:
+		return func(p unsafe.Pointer) bool { return *(*bool)(p) }
+	}
+	if check := intCheck(t, func(v int64) bool { return v != 0 }); check != nil {
+		return check
+	}
+	if check := uintCheck(t, func(v uint64) bool { return v != 0 }); check != nil {
+		return check
+	}
+	return floatCheck(t, func(v float64) bool { return v != 0 })
+}
+
+// stringCheck returns a fastCheck that calls pass with the value of a string of type t, or nil if t is not a string.
+func stringCheck(t reflect.Type, pass func(v string) bool) fastCheck {
+	if t.Kind() != reflect.String {
+		return nil
+	}
+	return func(p unsafe.Pointer) bool { return pass(*(*string)(p)) }
+}
+
+// intCheck returns a fastCheck that calls pass with the value of a signed integer of type t, or nil if t is not a
+// signed integer.
+func intCheck(t reflect.Type, pass func(v int64) bool) fastCheck {
+	switch t.Kind() {
+	case reflect.Int:
+		return func(p unsafe.Pointer) bool { return pass(int64(*(*int)(p))) }
+	case reflect.Int8:
+		return func(p unsafe.Pointer) bool { return pass(int64(*(*int8)(p))) }
+	case reflect.Int16:
+		return func(p unsafe.Pointer) bool { return pass(int64(*(*int16)(p))) }
+	case reflect.Int32:
+		return func(p unsafe.Pointer) bool { return pass(int64(*(*int32)(p))) }
+	case reflect.Int64:
+		return func(p unsafe.Pointer) bool { return pass(*(*int64)(p)) }
+	}
+	return nil
+}
+
+// uintCheck returns a fastCheck that calls pass with the value of an unsigned integer of type t, or nil if t is not an
+// unsigned integer.
+func uintCheck(t reflect.Type, pass func(v uint64) bool) fastCheck {
+	switch t.Kind() {
+	case reflect.Uint:
+		return func(p unsafe.Pointer) bool { return pass(uint64(*(*uint)(p))) }
+	case reflect.Uint8:
+		return func(p unsafe.Pointer) bool { return pass(uint64(*(*uint8)(p))) }
+	case reflect.Uint16:
+		return func(p unsafe.Pointer) bool { return pass(uint64(*(*uint16)(p))) }
+	case reflect.Uint32:
+		return func(p unsafe.Pointer) bool { return pass(uint64(*(*uint32)(p))) }
+	case reflect.Uint64:
+		return func(p unsafe.Pointer) bool { return pass(*(*uint64)(p)) }
+	case reflect.Uintptr:
+		return func(p unsafe.Pointer) bool { return pass(uint64(*(*uintptr)(p))) }
+	}
+	return nil
+}
+
+// floatCheck returns a fastCheck that calls pass with the value of a float of type t, or nil if t is not a float.
+func floatCheck(t reflect.Type, pass func(v float64) bool) fastCheck {
+	switch t.Kind() {
+	case reflect.Float32:
+		return func(p unsafe.Pointer) bool { return pass(float64(*(*float32)(p))) }
+	case reflect.Float64:
+		return func(p unsafe.Pointer) bool { return pass(*(*float64)(p)) }
+	}
+	return nil
+}
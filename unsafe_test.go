@@ -0,0 +1,55 @@
+package verify_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+type unsafeStruct struct {
+	A string   `verify:"required,minSize=2,maxSize=4"`
+	B string   `verify:"omitempty,len=3"`
+	C int8     `verify:"min=-1,lt=100"`
+	D uint16   `verify:"gte=1,lte=10"`
+	E float32  `verify:"gt=0.5,max=2.0"`
+	F bool     `verify:"required"`
+	G *int     `verify:"min=1"`
+	H []string `verify:"minSize=1"`
+	I string   `verify:"oneof=a b"`
+	J int64    `verify:"warn:max=5"`
+	k uint     `verify:"required"`
+}
+
+func TestWithUnsafeFieldAccess(t *testing.T) {
+	zero := 0
+	valid := unsafeStruct{A: "ab", C: -1, D: 1, E: 1, F: true, H: []string{"a"}, I: "a", k: 1}
+
+	tests := []struct {
+		name  string
+		input func(v *unsafeStruct)
+	}{
+		{"valid", func(v *unsafeStruct) {}},
+		{"zero", func(v *unsafeStruct) { *v = unsafeStruct{} }},
+		{"strings", func(v *unsafeStruct) { v.A, v.B, v.I = "abcde", "ab", "c" }},
+		{"numbers", func(v *unsafeStruct) { v.C, v.D, v.E, v.J = 100, 11, 0.5, 6 }},
+		{"pointer", func(v *unsafeStruct) { v.G = &zero }},
+	}
+	fast := verify.New(verify.WithUnsafeFieldAccess())
+	slow := verify.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := valid
+			tt.input(&v)
+			want := slow.Evaluate(&v)
+			for _, got := range []verify.Result{fast.Evaluate(&v), {Err: fast.It(&v)}, {Err: fast.It(v)}} {
+				if !reflect.DeepEqual(got.Err, want.Err) {
+					t.Errorf("want %v, got %v", want.Err, got.Err)
+				}
+			}
+			if got := fast.Evaluate(&v); !reflect.DeepEqual(got, want) {
+				t.Errorf("want %+v, got %+v", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package verify
+
+import (
+	"context"
+	"io/fs"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var defaultValidator = New()
+
+// Validator verifies structs based on their struct field tags. Different parts of an application may each create a
+// Validator configured to their needs. The tags of each struct type are parsed once and cached by the Validator, so a
+// Validator should be reused rather than created per call. A Validator is safe for concurrent use.
+type Validator struct {
+	tagName  string
+	failMode FailMode
+	// fsys is the file system set by WithFS, or nil to use the operating system's.
+	fsys fs.FS
+	// jsonPointer is set by WithJSONPointer.
+	jsonPointer bool
+	// nameTag is the tag key set by WithFieldNameTag, or empty to report fields by their Go names.
+	nameTag string
+	// nameFunc is the function set by WithFieldNameFunc, if any.
+	nameFunc func(reflect.StructField) string
+	// valueFunc formats the value appended to each message, set by WithValueInMessage.
+	valueFunc func(v interface{}) string
+	// maxErrors is the number of FieldErrors set by WithMaxErrors, or zero to report every one.
+	maxErrors int
+	// sortErrors is set by WithSortedErrors.
+	sortErrors bool
+	// dryRun holds the sub-tags and aliases named by WithDryRun.
+	dryRun map[string]bool
+	// parallelMin and parallelWorkers are set by WithParallelDive.
+	parallelMin     int
+	parallelWorkers int
+	// unsafeAccess is set by WithUnsafeFieldAccess.
+	unsafeAccess bool
+	// compileMode is set by WithCompileMode.
+	compileMode CompileMode
+	// maxDepth and maxElements are set by WithMaxDepth and WithMaxElements.
+	maxDepth    int
+	maxElements int
+
+	// precompiled holds the struct types registered with Precompile or Compile as keys.
+	precompiled sync.Map
+
+	// cache holds the compiled *structRules of each struct type verified, keyed by reflect.Type.
+	cache sync.Map
+	// varCache holds the compiled rules of the most recent calls to Var.
+	varCache *lruCache[varKey, *valueRules]
+
+	mu        sync.RWMutex
+	custom    map[string]func(Field) error
+	aliases   map[string]string
+	typeFuncs map[reflect.Type]TypeFunc
+	// structFuncs holds the functions registered with RegisterStructValidation, keyed by struct type.
+	structFuncs map[reflect.Type]func(*StructLevel)
+	// passwords holds the policies registered with RegisterPasswordPolicy, keyed by name.
+	passwords map[string]PasswordPolicy
+	// messages holds the templates registered with RegisterMessage, keyed by sub-tag.
+	messages map[string]string
+	// catalogs holds the messages registered with RegisterCatalog, keyed by locale and then by sub-tag.
+	catalogs map[string]map[string]string
+	// failureFuncs holds the functions registered with OnFailure.
+	failureFuncs []func(Failure)
+}
+
+// FailMode controls how much of a struct a Validator verifies once a rule has failed.
+type FailMode int
+
+const (
+	// EvaluateAll verifies every rule of every field, so all failures are reported. This is the default.
+	EvaluateAll FailMode = iota
+	// FailFirstRule stops verifying a field at its first failed rule, but still verifies every other field.
+	FailFirstRule
+	// FailFirstField stops verifying entirely at the first failed rule, so at most one failure is reported.
+	FailFirstField
+)
+
+// Option configures a Validator created with New.
+type Option func(*Validator)
+
+// WithTagName sets the struct field tag key the Validator reads its rules from. The default is "verify".
+func WithTagName(name string) Option {
+	return func(vd *Validator) {
+		vd.tagName = name
+	}
+}
+
+// WithFailMode sets how much of a struct the Validator verifies once a rule has failed. The default is EvaluateAll.
+func WithFailMode(m FailMode) Option {
+	return func(vd *Validator) {
+		vd.failMode = m
+	}
+}
+
+// WithJSONPointer makes the Validator set the Pointer of each FieldError to the location of the field as an RFC 6901
+// JSON Pointer, such as /Items/2/SKU, so clients can highlight the part of a document that failed.
+func WithJSONPointer() Option {
+	return func(vd *Validator) {
+		vd.jsonPointer = true
+	}
+}
+
+// WithFieldNameTag makes the Validator report each field by the name given by the struct field tag key, such as json,
+// in place of its Go name, so errors refer to user_name rather than UserName. The name is the part of the tag before
+// any comma; fields whose tag is missing, empty, or "-" are reported by their Go name.
+func WithFieldNameTag(key string) Option {
+	return func(vd *Validator) {
+		vd.nameTag = key
+	}
+}
+
+// WithFieldNameFunc makes the Validator report each field by the name fn returns for it, for example to render names in
+// snake case or add a prefix. If fn returns an empty string the field is named as it would be without fn, so fn may
+// handle only some fields. fn is mostly called when a struct type is compiled, but may be called again while verifying,
+// so it must be safe for concurrent use.
+func WithFieldNameFunc(fn func(reflect.StructField) string) Option {
+	return func(vd *Validator) {
+		vd.nameFunc = fn
+	}
+}
+
+// WithValueInMessage makes the Validator append the value of the field that failed to the message of each failure of a
+// sub-tag or struct validation function, as in `Name has a length less than 3 (got "ab")`, which helps when debugging
+// batch imports. The value is formatted by format, or if format is nil, with strings quoted and pointers followed.
+func WithValueInMessage(format func(v interface{}) string) Option {
+	return func(vd *Validator) {
+		if format == nil {
+			format = formatValue
+		}
+		vd.valueFunc = format
+	}
+}
+
+// WithMaxErrors makes the Validator stop verifying once more than n failures have been found and report only the first
+// n, setting Truncated on the ValidationError, so huge structs and batch payloads produce bounded responses and logs.
+// An n of zero or less reports every failure, which is the default.
+func WithMaxErrors(n int) Option {
+	return func(vd *Validator) {
+		vd.maxErrors = n
+	}
+}
+
+// WithSortedErrors makes the Validator sort the FieldErrors of each ValidationError by the path of their field, as
+// ValidationError.Sort does, rather than reporting them in the order the fields are declared. With WithMaxErrors the
+// failures reported are still the first found, and only they are sorted.
+func WithSortedErrors() Option {
+	return func(vd *Validator) {
+		vd.sortErrors = true
+	}
+}
+
+// WithDryRun makes the Validator verify the rules of each of the named sub-tags, or of each rule of the named aliases,
+// as if they had the warn: prefix: their failures are reported in the Warnings of the Result returned by Evaluate, but
+// never cause It to return an error. This allows new constraints, or a group of them registered with RegisterAlias, to
+// be shadow deployed against production traffic before they are enforced.
+func WithDryRun(tags ...string) Option {
+	return func(vd *Validator) {
+		if vd.dryRun == nil {
+			vd.dryRun = make(map[string]bool, len(tags))
+		}
+		for _, tag := range tags {
+			vd.dryRun[tag] = true
+		}
+	}
+}
+
+// New creates a Validator configured with the provided options.
+func New(opts ...Option) *Validator {
+	vd := &Validator{
+		tagName:  verifyTagKey,
+		varCache: newLRUCache[varKey, *valueRules](varCacheSize),
+	}
+	for _, opt := range opts {
+		opt(vd)
+	}
+	return vd
+}
+
+// It verifies v based on its struct field tags in the same way as the package level It, using the options the
+// Validator was configured with.
+func (vd *Validator) It(v interface{}) error {
+	return vd.itValue(context.Background(), reflect.ValueOf(v))
+}
+
+// ItContext verifies v in the same way as the package level ItContext, using the options the Validator was
+// configured with.
+func (vd *Validator) ItContext(ctx context.Context, v interface{}) error {
+	return vd.itValue(ctx, reflect.ValueOf(v))
+}
+
+// ItValue verifies rv in the same way as the package level ItValue, using the options the Validator was configured
+// with.
+func (vd *Validator) ItValue(rv reflect.Value) error {
+	return vd.itValue(context.Background(), rv)
+}
+
+func (vd *Validator) itValue(ctx context.Context, rv reflect.Value) error {
+	st := newState(ctx)
+	defer putState(st)
+	return vd.evaluateValue(st, rv).Err
+}
+
+func (vd *Validator) evaluateValue(st *state, rv reflect.Value) Result {
+	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return Result{Err: errInvalidKind}
+	}
+
+	sr, err := vd.structRules(rv.Type())
+	if err != nil {
+		return Result{Err: err}
+	}
+	return vd.verify(st, sr, rv)
+}
+
+// Var verifies v against rules in the same way as the package level Var, using the options the Validator was
+// configured with.
+func (vd *Validator) Var(v interface{}, rules string) error {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		// a nil interface has no type of its own, so verify it as the zero value of interface{}
+		rv = reflect.Zero(reflect.TypeOf((*interface{})(nil)).Elem())
+	}
+
+	vr, err := vd.varRules(rv.Type(), rules)
+	if err != nil {
+		return err
+	}
+	st := newState(context.Background())
+	defer putState(st)
+	// the value is named as if it were a field, but has no JSON Pointer of its own
+	root := st.field(location{pointers: vd.jsonPointer}, varFieldName, "")
+	if err := vd.verifyValue(st, vr, reflect.Value{}, rv, location{}, root); err != nil {
+		return err
+	}
+	return vd.result(st).Err
+}
+
+// varKey identifies the compiled rules of a call to Var in the cache of a Validator.
+type varKey struct {
+	typ   reflect.Type
+	rules string
+}
+
+// varCacheSize is the number of compiled rules of calls to Var kept by each Validator. It is bounded so rules built at
+// run time, such as "max="+strconv.Itoa(n), do not grow the cache without limit.
+const varCacheSize = 1000
+
+// varRules returns the compiled rules for a value of type t verified against rules by Var, compiling them only if they
+// are not already cached by the Validator.
+func (vd *Validator) varRules(t reflect.Type, rules string) (*valueRules, error) {
+	key := varKey{typ: t, rules: rules}
+	if vr, ok := vd.varCache.get(key); ok {
+		return vr, nil
+	}
+	vr, err := vd.newCompiler().compileValue(nil, varFieldName, t, splitTag(rules))
+	if err != nil {
+		return nil, &ConfigError{Err: err}
+	}
+	return vd.varCache.add(key, vr), nil
+}
+
+// fieldName returns the name sf is reported by in errors.
+func (vd *Validator) fieldName(sf reflect.StructField) string {
+	if vd.nameFunc != nil {
+		if name := vd.nameFunc(sf); name != "" {
+			return name
+		}
+	}
+	if vd.nameTag == "" {
+		return sf.Name
+	}
+	name, _, _ := strings.Cut(sf.Tag.Get(vd.nameTag), ",")
+	if name == "" || name == tagSkip {
+		return sf.Name
+	}
+	return name
+}
+
+// appendValue appends the value of the field that failed to the message of fe if the Validator was created with
+// WithValueInMessage.
+func (vd *Validator) appendValue(fe *FieldError) {
+	if vd.valueFunc == nil {
+		return
+	}
+	value := Redacted
+	if !fe.secret {
+		value = vd.valueFunc(fe.Value)
+	}
+	suffix := " (got " + value + ")"
+	fe.msg += suffix
+	fe.valueLen = len(suffix)
+}
+
+// stop reports whether verification should stop now that st has found a FieldError and the Validator stops at the
+// first failure, or now that st has found more FieldErrors than the Validator reports.
+func (vd *Validator) stop(st *state) bool {
+	n := len(st.errs)
+	return n > 0 && vd.failMode == FailFirstField || vd.maxErrors > 0 && n > vd.maxErrors
+}
+
+// report adds errs to st, or only the first of errs if the Validator stops at the first failure. It is used for errors
+// reported together by user code, such as a struct validation function, about the fields of the struct type t.
+func (vd *Validator) report(st *state, t reflect.Type, errs []*FieldError) {
+	if vd.failMode == FailFirstField && len(errs) > 1 {
+		errs = errs[:1]
+	}
+	st.errs = append(st.errs, errs...)
+	for _, fe := range errs {
+		st.recordRule(st.findField(fe.Field, fe.Pointer), RuleResult{Tag: fe.Tag, Param: fe.Param, Outcome: Failed,
+			Error: fe})
+		vd.notify(t, fe, false)
+	}
+}
+
+// validationError returns a ValidationError holding errs, truncated to the number set by WithMaxErrors and sorted if
+// the Validator was created with WithSortedErrors.
+func (vd *Validator) validationError(errs []*FieldError) *ValidationError {
+	ve := &ValidationError{Errors: errs}
+	if vd.maxErrors > 0 && len(errs) > vd.maxErrors {
+		ve.Errors, ve.Truncated = errs[:vd.maxErrors], true
+	}
+	if vd.sortErrors {
+		ve.Sort()
+	}
+	return ve
+}
+
+// clearCache discards every compiled struct type so they are compiled again with the Validator's current
+// registrations.
+func (vd *Validator) clearCache() {
+	vd.cache.Range(func(k, _ interface{}) bool {
+		vd.cache.Delete(k)
+		return true
+	})
+	vd.varCache.clear()
+}
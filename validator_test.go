@@ -0,0 +1,378 @@
+package verify_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+func TestValidatorIt(t *testing.T) {
+	type A struct {
+		A string `verify:"minSize=2"`
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"bool input", true, true},
+		{"struct input", A{"ab"}, false},
+		{"*struct input", &A{"ab"}, false},
+		{"field fails", A{"a"}, true},
+	}
+	v := verify.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestWithTagName(t *testing.T) {
+	type A struct {
+		A string `validate:"minSize=2" verify:"maxSize=0"`
+	}
+
+	tests := []struct {
+		name    string
+		v       *verify.Validator
+		input   interface{}
+		wantErr bool
+	}{
+		{"default tag fails", verify.New(), A{"ab"}, true},
+		{"custom tag works", verify.New(verify.WithTagName("validate")), A{"ab"}, false},
+		{"custom tag fails", verify.New(verify.WithTagName("validate")), A{"a"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestWithFailMode(t *testing.T) {
+	type A struct {
+		A int    `verify:"min=1,max=-1"`
+		B string `verify:"minSize=2"`
+		C []int  `verify:"dive,min=1"`
+	}
+
+	tests := []struct {
+		name string
+		mode verify.FailMode
+		want int
+	}{
+		{"evaluate all", verify.EvaluateAll, 5},
+		{"first rule", verify.FailFirstRule, 4},
+		{"first field", verify.FailFirstField, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.New(verify.WithFailMode(tt.mode)).It(A{C: []int{0, 0}})
+			var ve *verify.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("expected a *verify.ValidationError, got %v", err)
+			}
+			if len(ve.Errors) != tt.want {
+				t.Errorf("want %d errors, got %d: %v", tt.want, len(ve.Errors), err)
+			}
+		})
+	}
+}
+
+func TestValidatorCachesConcurrently(t *testing.T) {
+	type Node struct {
+		Children []*Node `verify:"dive,required"`
+		Name     string  `verify:"minSize=1"`
+	}
+	type Bad struct {
+		A bool `verify:"min=abc"`
+	}
+
+	v := verify.New()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := v.It(Node{Name: "a", Children: []*Node{{Name: "b"}}}); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if err := v.It(Node{Children: []*Node{nil}}); err == nil {
+				t.Error("expected an error")
+			}
+			if err := v.It(Bad{}); err == nil {
+				t.Error("expected invalid tags to fail on every call")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWithValueInMessage(t *testing.T) {
+	type A struct {
+		A string  `verify:"minSize=3"`
+		B *int    `verify:"required"`
+		C []int   `verify:"dive,max=1"`
+		D float64 `verify:"max=1.0,msg=is too large"`
+	}
+
+	tests := []struct {
+		name   string
+		format func(v interface{}) string
+		want   []string
+	}{
+		{"default format", nil, []string{
+			`A has a length less than 3 (got "ab")`,
+			"B is required but is set to zero value (got nil)",
+			"C[0] has value greater than max 1 (got 2)",
+			"D is too large (got 1.5)",
+		}},
+		{"custom format", func(v interface{}) string { return fmt.Sprintf("%T", v) }, []string{
+			"A has a length less than 3 (got string)",
+			"B is required but is set to zero value (got *int)",
+			"C[0] has value greater than max 1 (got int)",
+			"D is too large (got float64)",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.New(verify.WithValueInMessage(tt.format)).It(A{A: "ab", C: []int{2}, D: 1.5})
+			var ve *verify.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("expected a *verify.ValidationError, got %v", err)
+			}
+			if len(ve.Errors) != len(tt.want) {
+				t.Fatalf("expected %d errors, got %v", len(tt.want), err)
+			}
+			for i, w := range tt.want {
+				if got := ve.Errors[i].Error(); got != w {
+					t.Errorf("error %d: want %q, got %q", i, w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestWithMaxErrors(t *testing.T) {
+	type A struct {
+		A int   `verify:"required"`
+		B []int `verify:"dive,min=1"`
+		C int   `verify:"required"`
+	}
+
+	tests := []struct {
+		name          string
+		max           int
+		input         A
+		wantCount     int
+		wantTruncated bool
+	}{
+		{"under the limit", 3, A{B: []int{0}}, 3, false},
+		{"stops in elements", 2, A{B: []int{0, 0, 0}}, 2, true},
+		{"stops after field", 1, A{B: []int{1}}, 1, true},
+		{"no limit", 0, A{B: []int{0, 0, 0}}, 5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.New(verify.WithMaxErrors(tt.max)).It(tt.input)
+			var ve *verify.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("expected a *verify.ValidationError, got %v", err)
+			}
+			if len(ve.Errors) != tt.wantCount || ve.Truncated != tt.wantTruncated {
+				t.Errorf("want %d errors truncated %v, got %d truncated %v", tt.wantCount, tt.wantTruncated,
+					len(ve.Errors), ve.Truncated)
+			}
+		})
+	}
+
+	err := verify.New(verify.WithMaxErrors(1)).Var([]int{0, 0}, "dive,min=1")
+	want := "verify found the following errors: [value[0] has value less than min 1, ...]"
+	if err == nil || err.Error() != want {
+		t.Errorf("want %q, got %v", want, err)
+	}
+}
+
+func TestWithSortedErrors(t *testing.T) {
+	type Item struct {
+		SKU string `verify:"required"`
+	}
+	type A struct {
+		Zip   string `verify:"required"`
+		Items []Item `verify:"dive"`
+		Age   int    `verify:"min=1,lt=0"`
+	}
+	input := A{Items: make([]Item, 11)}
+	input.Items[2].SKU = "a"
+
+	fields := func(err error) []string {
+		var ve *verify.ValidationError
+		if !errors.As(err, &ve) {
+			t.Fatalf("expected a *verify.ValidationError, got %v", err)
+		}
+		var got []string
+		for _, fe := range ve.Errors {
+			got = append(got, fe.Field+" "+fe.Tag)
+		}
+		return got
+	}
+
+	declared := []string{
+		"Zip required", "Items[0].SKU required", "Items[1].SKU required", "Items[3].SKU required",
+		"Items[4].SKU required", "Items[5].SKU required", "Items[6].SKU required", "Items[7].SKU required",
+		"Items[8].SKU required", "Items[9].SKU required", "Items[10].SKU required", "Age min", "Age lt",
+	}
+	// the order must not vary between runs
+	for i := 0; i < 10; i++ {
+		if got := fields(verify.New().It(input)); !reflect.DeepEqual(got, declared) {
+			t.Fatalf("want %v, got %v", declared, got)
+		}
+	}
+
+	sorted := []string{
+		"Age min", "Age lt", "Items[0].SKU required", "Items[1].SKU required", "Items[3].SKU required",
+		"Items[4].SKU required", "Items[5].SKU required", "Items[6].SKU required", "Items[7].SKU required",
+		"Items[8].SKU required", "Items[9].SKU required", "Items[10].SKU required", "Zip required",
+	}
+	if got := fields(verify.New(verify.WithSortedErrors()).It(input)); !reflect.DeepEqual(got, sorted) {
+		t.Errorf("want %v, got %v", sorted, got)
+	}
+
+	want := []string{"Items[0].SKU required", "Zip required"}
+	vd := verify.New(verify.WithSortedErrors(), verify.WithMaxErrors(2))
+	if got := fields(vd.It(input)); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected only the reported failures to be sorted, want %v, got %v", want, got)
+	}
+}
+
+func TestWithDryRun(t *testing.T) {
+	vd := verify.New(verify.WithDryRun("maxSize", "strictName"))
+	vd.RegisterAlias("strictName", "omitempty,minSize=2,alpha")
+	vd.RegisterAlias("code", "len=3")
+	vd.RegisterValidation("alpha", func(f verify.Field) error {
+		for _, r := range f.Value.String() {
+			if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+				return errors.New("not a letter")
+			}
+		}
+		return nil
+	})
+
+	type A struct {
+		A string `verify:"required,maxSize=3"`
+		B string `verify:"strictName"`
+		C string `verify:"warn:code"`
+		D string `verify:"code"`
+	}
+
+	tests := []struct {
+		name         string
+		input        A
+		wantErr      bool
+		wantWarnings []string
+	}{
+		{"passes", A{A: "abc", B: "ab", C: "abc", D: "abc"}, false, nil},
+		{"only dry run failures", A{A: "abcd", B: "1", C: "ab", D: "abc"}, false, []string{
+			"A maxSize", "B minSize", "B alpha", "C len",
+		}},
+		{"enforced failures", A{B: "", C: "abc", D: "ab"}, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := vd.Evaluate(tt.input)
+			if (res.Err != nil) != tt.wantErr {
+				t.Errorf("wantErr is %v, got %v", tt.wantErr, res.Err)
+			}
+			var got []string
+			for _, fe := range res.Warnings {
+				got = append(got, fe.Field+" "+fe.Tag)
+			}
+			if !reflect.DeepEqual(got, tt.wantWarnings) {
+				t.Errorf("want warnings %v, got %v", tt.wantWarnings, got)
+			}
+			if err := vd.It(tt.input); (err != nil) != tt.wantErr {
+				t.Errorf("expected It to ignore dry run failures, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVarCachesRules(t *testing.T) {
+	vd := verify.New()
+	if allocs := testing.AllocsPerRun(100, func() {
+		if err := vd.Var(5, "required,min=1,max=10"); err != nil {
+			t.Fatal(err)
+		}
+	}); allocs != 0 {
+		t.Errorf("expected the rules to be parsed once, got %v allocations per call", allocs)
+	}
+
+	if err := vd.Var("abc", "code"); err != nil {
+		t.Errorf("expected an unknown sub-tag to be skipped, got %v", err)
+	}
+	vd.RegisterAlias("code", "len=2")
+	if err := vd.Var("abc", "code"); err == nil {
+		t.Error("expected registering an alias to replace the cached rules")
+	}
+
+	// rules built at run time are evicted from the cache, and compiled again when they are next used
+	for i := 0; i < 2000; i++ {
+		if err := vd.Var(i, "max="+strconv.Itoa(i)); err != nil {
+			t.Fatalf("max=%d: %v", i, err)
+		}
+		if err := vd.Var(i+1, "max="+strconv.Itoa(i)); err == nil {
+			t.Fatalf("max=%d: expected %d to fail", i, i+1)
+		}
+	}
+	if err := vd.Var(1, "max=0"); err == nil {
+		t.Error("expected evicted rules to be compiled again")
+	}
+}
+
+func TestValidatorErrorsAreNotShared(t *testing.T) {
+	type A struct {
+		A string `verify:"required"`
+		B []int  `verify:"dive,min=1"`
+	}
+	vd := verify.New()
+
+	var ve1 *verify.ValidationError
+	if !errors.As(vd.It(A{B: []int{0}}), &ve1) {
+		t.Fatal("expected a *verify.ValidationError")
+	}
+	want := ve1.Error()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				err := vd.It(A{A: "a", B: []int{0, 0, 0}})
+				var ve *verify.ValidationError
+				if !errors.As(err, &ve) || len(ve.Errors) != 3 || ve.Errors[2].Field != "B[2]" {
+					t.Errorf("unexpected error %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := ve1.Error(); got != want {
+		t.Errorf("expected an earlier error to be unchanged by later calls, want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,113 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const tagVerifier = "Verify"
+
+// Verifier is implemented by types that verify themselves. When a struct being verified, or the type of one of its
+// exported fields or dive elements, implements Verifier its Verify method is called after its tags have been verified.
+// If Verify returns a *ValidationError its FieldErrors are merged into the result, with their fields named relative to
+// the value Verify was called on, otherwise the error is reported as a FieldError with the tag Verify that wraps it.
+type Verifier interface {
+	Verify() error
+}
+
+var verifierType = reflect.TypeOf((*Verifier)(nil)).Elem()
+
+// implementsVerifier reports whether values of t, or pointers to them, implement Verifier.
+func implementsVerifier(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface {
+		return false
+	}
+	return t.Implements(verifierType) || reflect.PtrTo(t).Implements(verifierType)
+}
+
+// callVerifier calls the Verify method of f, the value at loc, returning the FieldErrors it reports. Nil pointers are
+// not verified. An error of the struct being verified itself is reported by the name of its type.
+func callVerifier(f reflect.Value, loc location) []*FieldError {
+	if !f.CanInterface() || (f.Kind() == reflect.Ptr && f.IsNil()) {
+		return nil
+	}
+	v, ok := f.Interface().(Verifier)
+	if !ok {
+		// Verify has a pointer receiver, so it must be called on an addressable copy if f is not addressable
+		if f.CanAddr() {
+			v = f.Addr().Interface().(Verifier)
+		} else {
+			p := reflect.New(f.Type())
+			p.Elem().Set(f)
+			v = p.Interface().(Verifier)
+		}
+	}
+
+	err := v.Verify()
+	if err == nil {
+		return nil
+	}
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return nestErrors(ve.Errors, loc)
+	}
+	name, pointer := loc.name(), loc.pointer()
+	if loc.st == nil {
+		name, pointer = loc.fieldPath(f.Type().Name())
+	}
+	return []*FieldError{{
+		Field:   name,
+		Tag:     tagVerifier,
+		Value:   f.Interface(),
+		Code:    tagCode(tagVerifier),
+		Pointer: pointer,
+		msg:     fmt.Sprintf("%s failed %s: %v", name, tagVerifier, err),
+		err:     err,
+	}}
+}
+
+// nestErrors returns copies of fes, the FieldErrors reported by the Verify method of the value at loc, with their Field
+// and message prefixed by the path of loc, and their Pointer by its JSON Pointer, so they name the fields within the
+// value being verified. fes are not modified, as they may be returned by Verify more than once.
+func nestErrors(fes []*FieldError, loc location) []*FieldError {
+	if loc.st == nil && !loc.pointers {
+		return fes
+	}
+	path := loc.name()
+	nested := make([]*FieldError, len(fes))
+	for i, fe := range fes {
+		c := *fe
+		c.Field = joinPath(path, fe.Field)
+		if strings.HasPrefix(c.msg, fe.Field) {
+			c.msg = c.Field + c.msg[len(fe.Field):]
+		}
+		if loc.pointers {
+			pointer := fe.Pointer
+			if pointer == "" {
+				pointer = pathPointer(fe.Field)
+			}
+			c.Pointer = loc.pointer() + pointer
+		}
+		nested[i] = &c
+	}
+	return nested
+}
+
+// joinPath returns the path of field, a path such as Items[2].SKU, within the value at path.
+func joinPath(path, field string) string {
+	if path == "" || field == "" || field[0] == '[' {
+		return path + field
+	}
+	return path + "." + field
+}
+
+// pathPointer returns the JSON Pointer of path, a path such as Items[2].SKU, for a FieldError reported without one.
+func pathPointer(path string) string {
+	var b strings.Builder
+	for _, name := range strings.FieldsFunc(path, func(r rune) bool { return r == '.' || r == '[' || r == ']' }) {
+		b.WriteString(pointerToken(name))
+	}
+	return b.String()
+}
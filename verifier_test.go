@@ -0,0 +1,139 @@
+package verify_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/codyoss/verify"
+)
+
+type sku string
+
+func (s sku) Verify() error {
+	if !strings.HasPrefix(string(s), "SKU-") {
+		return errors.New("must start with SKU-")
+	}
+	return nil
+}
+
+type lineItem struct {
+	SKU      sku
+	Quantity int `verify:"min=1"`
+}
+
+func (l *lineItem) Verify() error {
+	if l.Quantity > 10 && l.SKU == "SKU-LIMITED" {
+		return &verify.ValidationError{Errors: []*verify.FieldError{{Field: "Quantity", Tag: "limited"}}}
+	}
+	return nil
+}
+
+type order struct {
+	Items []lineItem `verify:"dive"`
+	Gift  *lineItem
+}
+
+func (o order) Verify() error {
+	if len(o.Items) == 0 && o.Gift == nil {
+		return errors.New("must contain items")
+	}
+	return nil
+}
+
+func TestVerifier(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     interface{}
+		wantCount int
+	}{
+		{"top-level fails", order{}, 1},
+		{"field fails", order{Items: []lineItem{{SKU: "a", Quantity: 1}}}, 1},
+		{"pointer receiver fails", order{Items: []lineItem{{SKU: "SKU-LIMITED", Quantity: 11}}}, 1},
+		{"pointer field fails", order{Gift: &lineItem{SKU: "SKU-LIMITED", Quantity: 11}}, 1},
+		{"tags and field fail", order{Items: []lineItem{{SKU: "a"}}}, 2},
+		{"works", order{Items: []lineItem{{SKU: "SKU-1", Quantity: 11}}}, 0},
+		{"works *struct", &order{Gift: &lineItem{SKU: "SKU-1", Quantity: 1}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.It(tt.input)
+			got := 0
+			var ve *verify.ValidationError
+			if errors.As(err, &ve) {
+				got = len(ve.Errors)
+			}
+			if got != tt.wantCount {
+				t.Errorf("want %d errors, got %v", tt.wantCount, err)
+			}
+		})
+	}
+}
+
+func TestVerifierError(t *testing.T) {
+	err := verify.It(order{Items: []lineItem{{SKU: "a", Quantity: 1}}})
+	var fe *verify.FieldError
+	if !errors.As(err, &fe) || fe.Field != "Items[0].SKU" || fe.Tag != "Verify" || fe.Value != sku("a") {
+		t.Errorf("expected a FieldError for the SKU field, got %+v", fe)
+	}
+	want := "verify found the following errors: [Items[0].SKU failed Verify: must start with SKU-]"
+	if err.Error() != want {
+		t.Errorf("want %q, got %q", want, err.Error())
+	}
+}
+
+type nestedItem struct {
+	N int
+}
+
+func (n nestedItem) Verify() error {
+	if n.N < 0 {
+		return &verify.ValidationError{Errors: []*verify.FieldError{{Field: "N", Tag: "positive"}}}
+	}
+	return nil
+}
+
+type nestedOrder struct {
+	Items []nestedItem `verify:"dive"`
+}
+
+func (o nestedOrder) Verify() error {
+	if len(o.Items) > 2 {
+		return &verify.ValidationError{Errors: []*verify.FieldError{{Field: "Items", Tag: "max"}}}
+	}
+	return nil
+}
+
+func TestVerifierNestedErrors(t *testing.T) {
+	type outer struct {
+		Orders []nestedOrder `json:"orders" verify:"dive"`
+	}
+	tests := []struct {
+		name        string
+		input       interface{}
+		opts        []verify.Option
+		wantField   string
+		wantPointer string
+	}{
+		{"dive element", nestedOrder{Items: []nestedItem{{1}, {-1}}}, nil, "Items[1].N", ""},
+		{"dive element with pointer", nestedOrder{Items: []nestedItem{{1}, {-1}}},
+			[]verify.Option{verify.WithJSONPointer()}, "Items[1].N", "/Items/1/N"},
+		{"struct being verified", nestedOrder{Items: make([]nestedItem, 3)},
+			[]verify.Option{verify.WithJSONPointer()}, "Items", "/Items"},
+		{"nested dive element", outer{Orders: []nestedOrder{{}, {Items: []nestedItem{{-1}}}}},
+			[]verify.Option{verify.WithJSONPointer(), verify.WithFieldNameTag("json")}, "orders[1].Items[0].N",
+			"/orders/1/Items/0/N"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.New(tt.opts...).It(tt.input)
+			var ve *verify.ValidationError
+			if !errors.As(err, &ve) || len(ve.Errors) != 1 {
+				t.Fatalf("expected a single FieldError, got %v", err)
+			}
+			if fe := ve.Errors[0]; fe.Field != tt.wantField || fe.Pointer != tt.wantPointer {
+				t.Errorf("want %s at %q, got %s at %q", tt.wantField, tt.wantPointer, fe.Field, fe.Pointer)
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Package verify uses struct field tags to verify data. There are five tags currently supported:
+// Package verify uses struct field tags to verify data. The following tags are currently supported:
 //
 // minSize -- specifies the minimum allowable length of a field. This can only be used on the following types: string,
 // slice, array, or map.
@@ -6,14 +6,122 @@
 // maxSize -- specifies the maximum allowable length of a field. This can only be used on the following types: string,
 // slice, array, or map.
 //
+// len -- specifies the exact length a field must have. This can only be used on the following types: string, slice,
+// array, or map.
+//
+// minRunes, maxRunes -- specify the minimum and maximum allowable number of characters in a field. Unlike minSize and
+// maxSize, which count bytes, these count Unicode code points, so a limit of 10 characters behaves as expected for
+// non-ASCII text. These can only be used on the following types: string.
+//
 // min -- specifies the minimum allowable value of a field. This should only be used on types that can be parsed into
-// an int64 or float64.
+// an int64, uint64, or float64.
 //
 // max -- specifies the maximum allowable value of a field. This should only be used on types that can be parsed into
-// an int64 or float64.
+// an int64, uint64, or float64.
+//
+// gt, lt -- specify the value of a field must be strictly greater than, or strictly less than, the given value, for
+// example `verify:"gt=0"`. These should only be used on types that can be parsed into an int64, uint64, or float64.
+//
+// gte, lte -- are aliases of min and max, for readability alongside gt and lt.
+//
+// between -- specifies the inclusive range the value of a field must be within, as the minimum and maximum separated by
+// a colon, for example `verify:"between=1:100"`. It verifies the same as min and max combined, but reports a single
+// failure. This should only be used on types that can be parsed into an int64, uint64, or float64.
+//
+// multipleOf -- specifies the value of a field must be a multiple of the given value, for example `verify:"multipleOf=5"`
+// for quantities sold in packs of five or `verify:"multipleOf=0.01"` for amounts in whole cents. This should only be used
+// on types that can be parsed into an int64, uint64, or float64.
+//
+// oneof -- specifies the field must be set to one of the space separated values, for example `verify:"oneof=red green
+// blue"` or `verify:"oneof=1 2 3"`. This can only be used on the following types: string, bool, int, uint, or float
+// types.
+//
+// eq, ne -- specify the field must be equal to, or not equal to, the given value, for example `verify:"ne=0"`. Unlike
+// required, ne=0 may be used to reject only zero while still allowing other values that are legal to store. These can
+// only be used on the following types: string, bool, int, uint, or float types.
+//
+// regex -- specifies the field must match the given regular expression, for example `verify:"regex=^[A-Z]{2}\\d{6}$"`.
+// Each pattern is compiled once and shared by every field that uses it, in a cache bounded by SetPatternCacheSize. A
+// value containing commas must be wrapped in single quotes, for example `verify:"regex='^a{1,3}$'"`; this applies to
+// the value of any tag. This can only be used on the following types: string.
+//
+// The min, max, gt, gte, lt, lte, and between tags may be used on time.Duration fields with values written as duration
+// strings, for example `verify:"min=1s,max=5m"` or `verify:"between=1s:5m"`.
+//
+// startswith, endswith, contains, excludes -- specify the field must start with, end with, contain, or not contain the
+// given value, for example `verify:"startswith=sk_"`. These can only be used on the following types: string.
+//
+// excludesall -- specifies the field must not contain any of the characters of the given value, for example
+// `verify:"excludesall=<>"`. This can only be used on the following types: string.
+//
+// password -- specifies the field must meet a password policy: DefaultPasswordPolicy if no value is given, or the
+// policy registered under the given name with RegisterPasswordPolicy, for example `verify:"password=admin"`. A
+// PasswordPolicy sets the length, required character classes, and a hook for banned passwords. The failure describes the
+// requirement that was not met. This can only be used on the following types: string.
+//
+// Each of the tags above may also be used on pointers to those types, in which case the value pointed to is verified.
+// Nil pointers are skipped, so required should be used as well if a value must be set.
 //
 // required -- specifies the field may not be set to the zero value for the given type. This may be used on any types
-// except arrays and structs.
+// except arrays and structs, other than time.Time, which is set when its IsZero method reports false. It is verified
+// before the field's other sub-tags, wherever it is written, and they are skipped if it fails, so a missing field
+// reports only that it is required. The same applies to required_if and the other conditional forms of required.
+//
+// notblank -- specifies the field must contain at least one character that is not whitespace, so unlike required a
+// value such as "   " fails. This can only be used on the following types: string, or pointers to strings, in which
+// case a nil pointer passes.
+//
+// omitempty -- specifies that the field's sub-tags, other than required and its conditional forms, are skipped when the
+// field is set to the zero value for its type, wherever omitempty is written, so optional fields are only verified when
+// present.
+//
+// msg -- specifies the message reported for each failure of the field's other sub-tags, following the field's name, in
+// place of the generated message, for example `verify:"len=8,msg='must be a valid promo code'"`. The message applies
+// to the sub-tags on the same side of dive, so one may be given for the field and another for its elements. The
+// wrapped sentinel error is unchanged, and the message is kept by Translate.
+//
+// secret -- specifies the value of the field must never be reported: the Value of its FieldErrors is set to Redacted,
+// so it is not echoed into logs or responses by messages or WithValueInMessage. Used before dive it applies to the
+// elements as well. This is intended for passwords, tokens, and personal data.
+//
+// warn: -- a prefix for any other sub-tag, for example `verify:"required,warn:maxSize=255"`, that reports its failures
+// as warnings rather than errors. Warnings never cause It to fail; they are returned separately in the Warnings of the
+// Result returned by Evaluate, so a tighter constraint can be observed in production before it is enforced.
+//
+// unique -- specifies the elements of a field must not contain duplicates. For a slice or array of structs, or pointers
+// to structs, the name of a field may be given so only that field must be unique, for example `verify:"unique=SKU"`.
+// This can only be used on the following types: slice or array, whose elements, or the named field, can be compared.
+//
+// dive -- specifies that every tag after it applies to each element of the field rather than the field itself. This
+// can only be used on the following types: slice or array. Elements that are structs, or pointers to structs, are
+// verified based on their own struct field tags. A struct that is reached again through a pointer while it is still
+// being verified, such as a node of a graph that is its own ancestor, is not verified again, so cycles end.
+//
+// eqfield, nefield, gtfield, ltfield -- specify that a field must be equal to, not equal to, greater than, or less
+// than the field of the same struct named by the tag's value, for example `verify:"eqfield=Password"`. Both fields must
+// have the same type. gtfield and ltfield can only be used on the following types: int, uint, and float types, string,
+// or time.Time.
+//
+// before, after -- specify a time.Time field must be before, or after, the given time, which is either an RFC 3339
+// time or the keyword now to compare against the time the field is verified, for example `verify:"after=now"`. These
+// may also be used on pointers to time.Time, in which case a nil pointer passes.
+//
+// file, dir -- specify the field must be the path of an existing file that is not a directory, or of an existing
+// directory. Paths are resolved on the operating system's file system, or the fs.FS set with WithFS. These can only be
+// used on the following types: string, or pointers to strings, in which case a nil pointer passes.
+//
+// format -- specifies the field must be in the string format registered under the given name with RegisterFormat, for
+// example `verify:"format=uuid"`. A registered format may also be used as a sub-tag of its own, for example
+// `verify:"uuid"`. Common formats are registered by importing the github.com/codyoss/verify/formats package. This can
+// only be used on the following types: string, []byte, or int and uint types, which are formatted in base 10.
+//
+// required_if, required_unless -- specify the field is required, in the same way as required, if or unless the other
+// fields of the same struct have the given values, for example `verify:"required_if=Type card"`. Several pairs of a
+// field and value may be given, in which case every field must have its value.
+//
+// required_with, required_without -- specify the field is required, in the same way as required, if any of the other
+// fields of the same struct named are set, or not set, to a value other than their zero value, for example
+// `verify:"required_without=Email Phone"`.
 //
 // Here is an example of the usage of each tag:
 //
@@ -24,217 +132,203 @@
 //		D float32 	`verify:"max=1.2"`
 //		E int64 	`verify:"min=3,max=7"`
 //		F *bool 	`verify:"required"`
+//		G []int 	`verify:"minSize=1,dive,min=1,max=100"`
+//		H string 	`verify:"omitempty,minSize=3"`
+//		I int64 	`verify:"gtfield=E"`
+//		J string 	`verify:"required_if=B card"`
+//		K string 	`verify:"oneof=red green blue"`
+//		L string 	`verify:"len=2"`
+//		M int 		`verify:"ne=0"`
+//		N float64 	`verify:"gt=0.0,lte=1.0"`
+//		O uint8 	`verify:"between=1:100"`
+//		P int 		`verify:"multipleOf=5"`
+//		Q string 	`verify:"regex='^[a-z]{2,8}$'"`
+//		R []string 	`verify:"unique"`
+//		S string 	`verify:"maxRunes=10"`
+//		T string 	`verify:"notblank"`
+//		U time.Time 	`verify:"required,after=2020-01-01T00:00:00Z,before=now"`
+//		V time.Duration `verify:"min=1s,max=5m"`
+//		W string 	`verify:"startswith=sk_,excludesall=<>"`
+//		X string 	`verify:"password"`
 //  }
 //
+// A field tagged with `verify:"-"` is skipped entirely, even if it is an embedded struct or implements Verifier.
+//
+// Embedded structs, and pointers to them, are verified as part of the outer struct so the tags on their promoted fields
+// are evaluated as well, whether or not the embedded type is exported.
+//
 // There are currently a few limitation with this project. The first is verify only supports working with flat
-// structures at the moment; it will not work with inner/embedded structs. Also, because the package makes use of
+// structures at the moment; it will not work with named inner structs. Also, because the package makes use of
 // reflection the tags may only be used on exported fields.
 package verify
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"reflect"
-	"strconv"
-	"strings"
 )
 
 const (
 	verifyTagKey = "verify"
+	varFieldName = "value"
 	tagMinSize   = "minSize"
 	tagMaxSize   = "maxSize"
+	tagLen       = "len"
+	tagMinRunes  = "minRunes"
+	tagMaxRunes  = "maxRunes"
 	tagMin       = "min"
 	tagMax       = "max"
+	tagGt        = "gt"
+	tagGte       = "gte"
+	tagLt        = "lt"
+	tagLte       = "lte"
+	tagBetween   = "between"
+	tagMultiple  = "multipleOf"
+	tagUnique    = "unique"
 	tagRequired  = "required"
+	tagNotBlank  = "notblank"
+	tagOneOf     = "oneof"
+	tagEq        = "eq"
+	tagNe        = "ne"
+	tagDive      = "dive"
+	tagOmitEmpty = "omitempty"
+	tagMsg       = "msg"
+	tagSecret    = "secret"
+	warnPrefix   = "warn:"
+	tagSkip      = "-"
 
 	parseBase = 10
 	parseBit  = 64
+
+	// multipleTolerance is the relative error allowed by multipleOf on float fields.
+	multipleTolerance = 1e-9
 )
 
 var (
 	errInvalidKind = errors.New("v provided must be a struct, interface, or pointer to a struct")
 
-	errMissingValueMinSize = errors.New("minSize must specify a size")
-	errMissingValueMaxSize = errors.New("maxSize must specify a size")
-	errMissingValueMin     = errors.New("min must specify a size")
-	errMissingValueMax     = errors.New("max must specify a size")
+	errMissingValueMinSize    = errors.New("minSize must specify a size")
+	errMissingValueMaxSize    = errors.New("maxSize must specify a size")
+	errMissingValueLen        = errors.New("len must specify a size")
+	errMissingValueMinRunes   = errors.New("minRunes must specify a size")
+	errMissingValueMaxRunes   = errors.New("maxRunes must specify a size")
+	errMissingValueMin        = errors.New("min must specify a size")
+	errMissingValueMax        = errors.New("max must specify a size")
+	errMissingValueGt         = errors.New("gt must specify a size")
+	errMissingValueLt         = errors.New("lt must specify a size")
+	errMissingValueBetween    = errors.New("between must specify a range")
+	errMissingValueMultipleOf = errors.New("multipleOf must specify a value")
+	errMissingValueOneOf      = errors.New("oneof must specify at least one value")
+	errMissingValueEq         = errors.New("eq must specify a value")
+	errMissingValueNe         = errors.New("ne must specify a value")
+	errMissingValueMsg        = errors.New("msg must specify a message")
 
-	errValueTypeMinSize = errors.New("minSize can only be used with types: string, slice, array, or map")
-	errValueTypeMaxSize = errors.New("maxSize can only be used with types: string, slice, array, or map")
-	errValueTypeMin     = errors.New("min can only be used with types: int, int8, int16, int32, int64, float32, or float64")
-	errValueTypeMax     = errors.New("max can only be used with types: int, int8, int16, int32, int64, float32, or float64")
+	errValueTypeMinSize    = errors.New("minSize can only be used with types: string, slice, array, or map")
+	errValueTypeMaxSize    = errors.New("maxSize can only be used with types: string, slice, array, or map")
+	errValueTypeLen        = errors.New("len can only be used with types: string, slice, array, or map")
+	errValueTypeMinRunes   = errors.New("minRunes can only be used with types: string")
+	errValueTypeMaxRunes   = errors.New("maxRunes can only be used with types: string")
+	errValueTypeMin        = errors.New("min can only be used with types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, or float64")
+	errValueTypeMax        = errors.New("max can only be used with types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, or float64")
+	errValueTypeGt         = errors.New("gt can only be used with types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, or float64")
+	errValueTypeLt         = errors.New("lt can only be used with types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, or float64")
+	errValueTypeBetween    = errors.New("between can only be used with types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, or float64")
+	errValueTypeMultipleOf = errors.New("multipleOf can only be used with types: int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr, float32, or float64")
 
-	errConvertToNumberMinSize = errors.New("minSize value must be an int")
-	errConvertToNumberMaxSize = errors.New("maxSize value must be an int")
-	errConvertToNumberMin     = errors.New("min value must be an int64 or float64")
-	errConvertToNumberMax     = errors.New("max value must be an int or float64")
+	errValueTypeOneOf = errors.New("oneof can only be used with types: string, bool, int, uint, or float types")
+	errValueTypeEq    = errors.New("eq can only be used with types: string, bool, int, uint, or float types")
+	errValueTypeNe    = errors.New("ne can only be used with types: string, bool, int, uint, or float types")
+
+	errValueTypeDive   = errors.New("dive can only be used with types: slice or array")
+	errValueTypeUnique = errors.New("unique can only be used with types: slice or array")
+
+	errValueTypeNotBlank = errors.New("notblank can only be used with types: string")
+
+	errConvertToNumberMinSize    = errors.New("minSize value must be an int")
+	errConvertToNumberMaxSize    = errors.New("maxSize value must be an int")
+	errConvertToNumberLen        = errors.New("len value must be an int")
+	errConvertToNumberMinRunes   = errors.New("minRunes value must be an int")
+	errConvertToNumberMaxRunes   = errors.New("maxRunes value must be an int")
+	errConvertToNumberMin        = errors.New("min value must be an int64 or float64")
+	errConvertToNumberMax        = errors.New("max value must be an int or float64")
+	errConvertToNumberGt         = errors.New("gt value must be an int64 or float64")
+	errConvertToNumberLt         = errors.New("lt value must be an int64 or float64")
+	errConvertToNumberBetween    = errors.New("between value must be two numbers separated by a colon, for example 1:100")
+	errConvertToNumberMultipleOf = errors.New("multipleOf value must be an int64 or float64")
 )
 
 // It takes a struct and uses reflection to verify it based on its struct field tags. An error is returned should any of
-// the fields fail their validation. The returned error will describe each field that failed validation. Only interfaces
-// a struct, or a pointer to struct should be passed to this function.
+// the fields fail their validation. Every field is verified before returning, so the returned *ValidationError will
+// hold a *FieldError for each field that failed validation, while a *ConfigError is returned if the tags themselves are
+// invalid. Only interfaces a struct, or a pointer to struct should be passed to this function. It uses a Validator
+// with the default options; see New to configure one.
 func It(v interface{}) error {
-	rv := reflect.ValueOf(v)
-
-	for rv.Kind() == reflect.Interface || rv.Kind() == reflect.Ptr {
-		rv = rv.Elem()
-	}
-	if rv.Kind() != reflect.Struct {
-		return errInvalidKind
-	}
-
-	rt := rv.Type()
-	// TODO: Append errors
-	for i := 0; i < rt.NumField(); i++ {
-		if tags, ok := rt.Field(i).Tag.Lookup(verifyTagKey); ok {
-			err := verifyField(rv.Field(i), rt.Field(i).Name, tags)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
+	return defaultValidator.It(v)
+}
+
+// ItContext verifies v in the same way as It, aborting early with the context's error if ctx is canceled or its
+// deadline is exceeded before every field has been verified. It uses a Validator with the default options; see New to
+// configure one.
+func ItContext(ctx context.Context, v interface{}) error {
+	return defaultValidator.ItContext(ctx, v)
+}
+
+// ItValue verifies rv in the same way as It. It is intended for frameworks, such as decoders, that already hold a
+// reflect.Value and would otherwise need to call Interface on it. rv should be a struct, or a pointer or interface
+// holding one; a struct is verified directly. It uses a Validator with the default options; see New to configure one.
+func ItValue(rv reflect.Value) error {
+	return defaultValidator.ItValue(rv)
+}
+
+// Evaluate verifies v in the same way as It, returning a Result that holds the error It would return along with the
+// outcome of every rule of every field, whether it passed, failed, or was skipped. Failures of sub-tags with the warn:
+// prefix are reported in the Result separately from the error. Recording the outcomes costs more than It, which should
+// be preferred when only the error is needed. It uses a Validator with the default options; see New to configure one.
+func Evaluate(v interface{}) Result {
+	return defaultValidator.Evaluate(v)
+}
+
+// Audit verifies v in the same way as Evaluate and returns an AuditReport recording every rule evaluated, its param, and
+// its outcome, including the rules that passed. The report may be serialized, for example as JSON, and kept as evidence
+// of the checks the value was subject to. An error is returned only if v could not be verified, such as when its tags
+// are invalid; a value that fails its rules is recorded in the report as not Valid. The Validator should use the default
+// FailMode of EvaluateAll, so no rule is left unrecorded. It uses a Validator with the default options; see New to
+// configure one.
+func Audit(v interface{}) (*AuditReport, error) {
+	return defaultValidator.Audit(v)
+}
+
+// Var verifies a standalone value against rules, which use the same syntax as a struct field tag, for example
+// "required,maxSize=10". This allows a query parameter or flag to be verified without declaring a struct to hold it.
+// Failures are reported with the field name "value". The rules most recently used with each type of value are cached,
+// so they are usually only parsed once.
+func Var(v interface{}, rules string) error {
+	return defaultValidator.Var(v, rules)
+}
+
+// Compile parses the struct field tags of t, a struct or pointer to struct type, ahead of time. The returned Compiled
+// verifies values of t without parsing any tags, and any error in the tags is returned by Compile rather than when a
+// value is verified. t is registered in the same way as by Precompile. It uses a Validator with the default options;
+// see New to configure one.
+func Compile(t reflect.Type) (*Compiled, error) {
+	return defaultValidator.Compile(t)
+}
+
+// Precompile compiles the rules of the struct type of each of the values, which may be zero values or nil pointers, so
+// they are not compiled when a value is first verified, returning the first error in their tags. The struct types
+// reached from them, such as the elements of a slice with dive, are compiled as well. A Validator created with
+// CompileEager only verifies values of the types registered this way, or with Compile, and the types reached from them
+// as part of those values. Registrations such as RegisterValidation discard compiled rules, so they should be made
+// before Precompile is called. It uses a Validator with the default options; see New to configure one.
+func Precompile(values ...interface{}) error {
+	return defaultValidator.Precompile(values...)
 }
 
-func verifyField(f reflect.Value, name string, tag string) error {
-	var tagErrs []string
-	var tagPrefix string
-	st := strings.Split(tag, ",")
-
-	// verify each valid sub-tag found
-	for _, v := range st {
-		tagPrefix = v
-		i := strings.IndexByte(v, '=')
-		if i != -1 {
-			tagPrefix = v[:i]
-		}
-		switch tagPrefix {
-		case tagMinSize:
-			if i == -1 {
-				return errMissingValueMinSize
-			}
-			min, err := strconv.Atoi(v[i+1:])
-			if err != nil {
-				return errConvertToNumberMinSize
-			}
-
-			switch f.Kind() {
-			case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
-				if f.Len() < min {
-					tagErrs = append(tagErrs, fmt.Sprintf("%s has a length less than %d", name, min))
-				}
-			default:
-				return errValueTypeMinSize
-			}
-		case tagMaxSize:
-			if i == -1 {
-				return errMissingValueMaxSize
-			}
-			max, err := strconv.Atoi(v[i+1:])
-			if err != nil {
-				return errConvertToNumberMaxSize
-			}
-
-			switch f.Kind() {
-			case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
-				if f.Len() > max {
-					tagErrs = append(tagErrs, fmt.Sprintf("%s has a length greater than %d", name, max))
-				}
-			default:
-				return errValueTypeMaxSize
-			}
-		case tagMin:
-			var minI int64
-			var minF float64
-			var isMinFloat bool
-			if i == -1 {
-				return errMissingValueMin
-			}
-			minI, err := strconv.ParseInt(v[i+1:], parseBase, parseBit)
-			if err != nil {
-				minF, err = strconv.ParseFloat(v[i+1:], parseBit)
-				if err != nil {
-					return errConvertToNumberMin
-				}
-				isMinFloat = true
-			}
-			switch f.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				if isMinFloat {
-					return fmt.Errorf("%s type is int while min is float", name)
-				}
-				if f.Int() < minI {
-					tagErrs = append(tagErrs, fmt.Sprintf("%s has value less than min %d", name, minI))
-				}
-			case reflect.Float32, reflect.Float64:
-				if !isMinFloat {
-					return fmt.Errorf("%s type is float while min is int", name)
-				}
-				if f.Float() < minF {
-					tagErrs = append(tagErrs, fmt.Sprintf("%s has value less than min %f", name, minF))
-				}
-			default:
-				return errValueTypeMin
-			}
-		case tagMax:
-			var maxI int64
-			var maxF float64
-			var isMaxFloat bool
-			if i == -1 {
-				return errMissingValueMax
-			}
-			maxI, err := strconv.ParseInt(v[i+1:], parseBase, parseBit)
-			if err != nil {
-				maxF, err = strconv.ParseFloat(v[i+1:], parseBit)
-				if err != nil {
-					return errConvertToNumberMax
-				}
-				isMaxFloat = true
-			}
-			switch f.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				if isMaxFloat {
-					return fmt.Errorf("%s type is int while max is float", name)
-				}
-				if f.Int() > maxI {
-					tagErrs = append(tagErrs, fmt.Sprintf("%s has value greater than max %d", name, maxI))
-				}
-			case reflect.Float32, reflect.Float64:
-				if !isMaxFloat {
-					return fmt.Errorf("%s type is float while max is int", name)
-				}
-				if f.Float() > maxF {
-					tagErrs = append(tagErrs, fmt.Sprintf("%s has value greater than max %f", name, maxF))
-				}
-			default:
-				return errValueTypeMax
-			}
-		case tagRequired:
-			switch f.Kind() {
-			case reflect.Func, reflect.Map, reflect.Slice:
-				if f.IsNil() {
-					tagErrs = append(tagErrs, fmt.Sprintf("%s is required but is set to zero value", name))
-				}
-			case reflect.Array, reflect.Struct:
-			default:
-				if f.Interface() == reflect.Zero(f.Type()).Interface() {
-					tagErrs = append(tagErrs, fmt.Sprintf("%s is required but is set to zero value", name))
-				}
-			}
-		}
-	}
-
-	// collect all errors to return to user
-	if tagErrs != nil {
-		var sb strings.Builder
-		for i, v := range tagErrs {
-			if i != 0 {
-				sb.WriteString(", ")
-			}
-			sb.WriteString(v)
-		}
-		return fmt.Errorf("verify found the following errors: [%s]", sb.String())
-	}
-
-	return nil
+// Check inspects the struct field tags of v, a struct or pointer to struct, and reports every configuration mistake
+// found, such as a sub-tag missing its value, a sub-tag used on a field of the wrong type, an unparsable value, or an
+// unknown sub-tag. Each mistake is reported as a *ConfigError. Only the type of v is inspected, so a zero value or nil
+// pointer may be passed. This allows invalid tags to be caught in tests or at startup rather than when a value is first
+// verified. It uses a Validator with the default options; see New to configure one.
+func Check(v interface{}) error {
+	return defaultValidator.Check(v)
 }
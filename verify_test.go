@@ -1,6 +1,10 @@
 package verify_test
 
 import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -106,6 +110,87 @@ func TestItMaxSize(t *testing.T) {
 	}
 }
 
+func TestItLen(t *testing.T) {
+	type A struct {
+		A bool `verify:"len"`
+	}
+	type B struct {
+		A bool `verify:"len=abc"`
+	}
+	type C struct {
+		A bool `verify:"len=2"`
+	}
+	type D struct {
+		A string         `verify:"len=2"`
+		B map[string]int `verify:"len=1"`
+		C *[]int         `verify:"len=0"`
+	}
+	one := []int{1}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"missing value", A{}, true},
+		{"can't parse value", B{}, true},
+		{"field wrong type", C{}, true},
+		{"field too short", D{"a", map[string]int{"a": 1}, nil}, true},
+		{"field too long", D{"abc", map[string]int{"a": 1}, nil}, true},
+		{"map wrong length", D{"ab", nil, nil}, true},
+		{"pointer wrong length", D{"ab", map[string]int{"a": 1}, &one}, true},
+		{"works", D{"ab", map[string]int{"a": 1}, nil}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItRunes(t *testing.T) {
+	type A struct {
+		A string `verify:"minRunes"`
+	}
+	type B struct {
+		A string `verify:"maxRunes=abc"`
+	}
+	type C struct {
+		A []string `verify:"maxRunes=2"`
+	}
+	type D struct {
+		A string  `verify:"minRunes=2,maxRunes=3"`
+		B *string `verify:"maxRunes=1"`
+	}
+	ascii, accented := "ab", "é"
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"missing value", A{}, true},
+		{"can't parse value", B{}, true},
+		{"field wrong type", C{}, true},
+		{"too few characters", D{A: "a"}, true},
+		{"too many characters", D{A: "日本語の"}, true},
+		{"pointer too many characters", D{A: "ab", B: &ascii}, true},
+		{"works multi-byte", D{A: "日本語", B: &accented}, false},
+		{"works more bytes than maxRunes", D{A: "éé"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
 func TestItMin(t *testing.T) {
 	type A struct {
 		A bool `verify:"min"`
@@ -202,6 +287,366 @@ func TestItMax(t *testing.T) {
 	}
 }
 
+func TestItGtLt(t *testing.T) {
+	type A struct {
+		A int `verify:"gt"`
+	}
+	type B struct {
+		A int `verify:"lt=abc"`
+	}
+	type C struct {
+		A string `verify:"gt=1"`
+	}
+	type D struct {
+		A int `verify:"lt=1.5"`
+	}
+	type E struct {
+		A float64 `verify:"gt=0.0,lte=1.0"`
+		B int     `verify:"gte=1,lt=10"`
+		C *uint   `verify:"gt=2"`
+	}
+	two, three := uint(2), uint(3)
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"missing value", A{}, true},
+		{"can't parse value", B{}, true},
+		{"field wrong type", C{}, true},
+		{"int field float value", D{}, true},
+		{"float equal to gt", E{A: 0, B: 1}, true},
+		{"float greater than lte", E{A: 1.5, B: 1}, true},
+		{"int equal to lt", E{A: 1, B: 10}, true},
+		{"int less than gte", E{A: 1, B: 0}, true},
+		{"uint equal to gt", E{A: 1, B: 1, C: &two}, true},
+		{"works", E{A: 0.01, B: 9, C: &three}, false},
+		{"works on bounds", E{A: 1, B: 1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItBetween(t *testing.T) {
+	type A struct {
+		A int `verify:"between"`
+	}
+	type B struct {
+		A int `verify:"between=1"`
+	}
+	type C struct {
+		A int `verify:"between=a:b"`
+	}
+	type D struct {
+		A string `verify:"between=1:2"`
+	}
+	type E struct {
+		A int `verify:"between=10:1"`
+	}
+	type F struct {
+		A int8 `verify:"between=1:300"`
+	}
+	type G struct {
+		A int     `verify:"between=1:100"`
+		B float64 `verify:"between=-0.5:0.5"`
+		C *uint   `verify:"between=2:4"`
+	}
+	five := uint(5)
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"missing value", A{}, true},
+		{"missing colon", B{}, true},
+		{"can't parse value", C{}, true},
+		{"field wrong type", D{}, true},
+		{"bounds reversed", E{}, true},
+		{"bound overflows field", F{}, true},
+		{"int too small", G{A: 0}, true},
+		{"int too large", G{A: 101}, true},
+		{"float too large", G{A: 1, B: 0.6}, true},
+		{"uint too large", G{A: 1, C: &five}, true},
+		{"works", G{A: 100, B: -0.5}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItBetweenSingleFailure(t *testing.T) {
+	type A struct {
+		A int `verify:"between=1:100"`
+	}
+	err := verify.It(A{A: 200})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) || len(ve.Errors) != 1 {
+		t.Fatalf("want a single failure, got %v", err)
+	}
+	if want := "A must be between 1 and 100"; ve.Errors[0].Error() != want {
+		t.Errorf("got %q, want %q", ve.Errors[0].Error(), want)
+	}
+	if !errors.Is(err, verify.ErrBetween) {
+		t.Errorf("want ErrBetween, got %v", err)
+	}
+}
+
+func TestItMultipleOf(t *testing.T) {
+	type A struct {
+		A int `verify:"multipleOf"`
+	}
+	type B struct {
+		A int `verify:"multipleOf=abc"`
+	}
+	type C struct {
+		A string `verify:"multipleOf=5"`
+	}
+	type D struct {
+		A int `verify:"multipleOf=0"`
+	}
+	type E struct {
+		A int `verify:"multipleOf=0.5"`
+	}
+	type F struct {
+		A int     `verify:"multipleOf=5"`
+		B float64 `verify:"multipleOf=0.01"`
+		C *uint8  `verify:"multipleOf=4"`
+	}
+	six, eight := uint8(6), uint8(8)
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"missing value", A{}, true},
+		{"can't parse value", B{}, true},
+		{"field wrong type", C{}, true},
+		{"zero value", D{}, true},
+		{"int field float value", E{}, true},
+		{"int not a multiple", F{A: 12}, true},
+		{"float not a multiple", F{A: 10, B: 19.995}, true},
+		{"uint not a multiple", F{A: 10, C: &six}, true},
+		{"works", F{A: -15, B: 19.99, C: &eight}, false},
+		{"works zero", F{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItUnsigned(t *testing.T) {
+	type A struct {
+		A uint32 `verify:"min=1,max=10"`
+	}
+	type B struct {
+		A uint64 `verify:"max=18446744073709551614"`
+	}
+	type C struct {
+		A uint8 `verify:"min=-1"`
+	}
+	type D struct {
+		A uint `verify:"max=1.5"`
+	}
+	type E struct {
+		A uint64 `verify:"required"`
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"negative value", C{}, true},
+		{"float value", D{}, true},
+		{"too small", A{0}, true},
+		{"too large", A{11}, true},
+		{"works", A{10}, false},
+		{"too large above max int64", B{18446744073709551615}, true},
+		{"works above max int64", B{18446744073709551614}, false},
+		{"required fails", E{0}, true},
+		{"works required above max int64", E{18446744073709551615}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItOneOf(t *testing.T) {
+	type Color string
+	type A struct {
+		A Color   `verify:"oneof=red green blue"`
+		B *int    `verify:"oneof=1 2 3"`
+		C float64 `verify:"omitempty,oneof=0.5 1.5"`
+	}
+	type B struct {
+		A []string `verify:"oneof=a"`
+	}
+	type C struct {
+		A int `verify:"oneof=a"`
+	}
+	type D struct {
+		A string `verify:"oneof"`
+	}
+	two, four := 2, 4
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"missing value", D{}, true},
+		{"field wrong type", B{}, true},
+		{"unparsable value", C{}, true},
+		{"string not allowed", A{A: "pink"}, true},
+		{"int not allowed", A{A: "red", B: &four}, true},
+		{"float not allowed", A{A: "red", C: 1}, true},
+		{"works", A{A: "green", B: &two, C: 1.5}, false},
+		{"works nil pointer", A{A: "blue"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItEqNe(t *testing.T) {
+	type Mode string
+	type A struct {
+		A Mode     `verify:"eq=strict"`
+		B int      `verify:"ne=0"`
+		C *float64 `verify:"ne=0.5"`
+		D bool     `verify:"eq=true"`
+		E uint     `verify:"omitempty,eq=7"`
+	}
+	type B struct {
+		A []int `verify:"eq=1"`
+	}
+	type C struct {
+		A int `verify:"ne=a"`
+	}
+	type D struct {
+		A int `verify:"eq"`
+	}
+	half, one := 0.5, 1.0
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"missing value", D{}, true},
+		{"field wrong type", B{}, true},
+		{"unparsable value", C{}, true},
+		{"string not equal", A{A: "lax", B: 1, D: true}, true},
+		{"int equal", A{A: "strict", D: true}, true},
+		{"float equal", A{A: "strict", B: -1, C: &half, D: true}, true},
+		{"bool not equal", A{A: "strict", B: 1}, true},
+		{"uint not equal", A{A: "strict", B: 1, D: true, E: 8}, true},
+		{"works", A{A: "strict", B: 1, C: &one, D: true, E: 7}, false},
+		{"works nil pointer", A{A: "strict", B: 1, D: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItUnique(t *testing.T) {
+	type Item struct {
+		SKU string
+		Qty int
+	}
+	type A struct {
+		A string `verify:"unique"`
+	}
+	type B struct {
+		A []Item `verify:"unique=Missing"`
+	}
+	type C struct {
+		A []string `verify:"unique=SKU"`
+	}
+	type D struct {
+		A [][]int `verify:"unique"`
+	}
+	type E struct {
+		A []int         `verify:"unique"`
+		B []*Item       `verify:"unique=SKU"`
+		C [2]string     `verify:"unique"`
+		D []interface{} `verify:"unique"`
+	}
+	type F struct {
+		a []int     `verify:"unique"`
+		b []Item    `verify:"unique=SKU"`
+		c [2]string `verify:"unique"`
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"field wrong type", A{}, true},
+		{"missing key field", B{}, true},
+		{"key field on non-struct", C{}, true},
+		{"elements not comparable", D{}, true},
+		{"duplicate ints", E{A: []int{1, 2, 1}, C: [2]string{"a", "b"}}, true},
+		{"duplicate keys", E{B: []*Item{{"a", 1}, nil, {"a", 2}}, C: [2]string{"a", "b"}}, true},
+		{"duplicate array", E{C: [2]string{"a", "a"}}, true},
+		{"duplicate interfaces", E{C: [2]string{"a", "b"}, D: []interface{}{1, "1", 1}}, true},
+		{"works", E{
+			A: []int{1, 2, 3},
+			B: []*Item{{"a", 1}, nil, nil, {"b", 1}},
+			C: [2]string{"a", "b"},
+			D: []interface{}{1, "1", []int{1}, []int{1}},
+		}, false},
+		{"unexported duplicate ints", F{a: []int{1, 1}}, true},
+		{"unexported duplicate keys", &F{b: []Item{{"a", 1}, {"a", 2}}}, true},
+		{"unexported duplicate array", F{c: [2]string{"a", "a"}}, true},
+		{"works unexported", F{a: []int{1, 2}, b: []Item{{"a", 1}, {"b", 1}}, c: [2]string{"a", "b"}}, false},
+		{"works unexported pointer", &F{a: []int{1, 2}, b: []Item{{"a", 1}, {"b", 1}}, c: [2]string{"a", "b"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
 func TestItRequired(t *testing.T) {
 
 	type Zero struct {
@@ -228,6 +673,13 @@ func TestItRequired(t *testing.T) {
 	type G struct {
 		A []int `verify:"required"`
 	}
+	type H struct {
+		A interface{} `verify:"required"`
+	}
+	type I struct {
+		A complex128 `verify:"required"`
+	}
+	negZero := math.Copysign(0, -1)
 
 	tests := []struct {
 		name    string
@@ -248,6 +700,88 @@ func TestItRequired(t *testing.T) {
 		{"non-deafult struct", E{Zero{"a"}}, false},
 		{"non-deafult *struct", F{&Zero{"a"}}, false},
 		{"non-deafult slice", G{[]int{1}}, false},
+		{"negative zero float64", D{negZero}, true},
+		{"default interface", H{}, true},
+		{"interface holding an uncomparable value", H{[]int{}}, false},
+		{"interface holding a zero value", H{0}, false},
+		{"default complex128", I{}, true},
+		{"non-default complex128", I{1i}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+
+	var v interface{} = 1000
+	if allocs := testing.AllocsPerRun(100, func() {
+		if err := verify.Var(v, "required"); err != nil {
+			t.Fatal(err)
+		}
+	}); allocs != 0 {
+		t.Errorf("expected required not to allocate, got %v allocations per call", allocs)
+	}
+}
+
+func TestItNotBlank(t *testing.T) {
+	type A struct {
+		A int `verify:"notblank"`
+	}
+	type B struct {
+		A string  `verify:"notblank"`
+		B *string `verify:"notblank"`
+	}
+	blank, name := " \t\n", "name"
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"field wrong type", A{}, true},
+		{"empty", B{}, true},
+		{"whitespace", B{A: "   "}, true},
+		{"pointer whitespace", B{A: "a", B: &blank}, true},
+		{"works", B{A: " a ", B: &name}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItPointers(t *testing.T) {
+	type A struct {
+		A *int     `verify:"min=1,max=3"`
+		B *string  `verify:"minSize=1,maxSize=3"`
+		C **int    `verify:"min=1"`
+		D *float64 `verify:"required,max=1.5"`
+	}
+	zero, one, four := 0, 1, 4
+	empty, abc, abcd := "", "abc", "abcd"
+	pZero, pOne := &zero, &one
+	half := 0.5
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"nil pointers skipped", A{D: &half}, false},
+		{"required nil pointer fails", A{}, true},
+		{"too small", A{A: &zero, D: &half}, true},
+		{"too large", A{A: &four, D: &half}, true},
+		{"too short", A{B: &empty, D: &half}, true},
+		{"too long", A{B: &abcd, D: &half}, true},
+		{"pointer to pointer fails", A{C: &pZero, D: &half}, true},
+		{"works", A{A: &one, B: &abc, C: &pOne, D: &half}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -257,20 +791,393 @@ func TestItRequired(t *testing.T) {
 			}
 		})
 	}
+
+	type B struct {
+		A *bool `verify:"min=1"`
+	}
+	if err := verify.Check(B{}); err == nil {
+		t.Error("expected min to be invalid on a *bool")
+	}
+}
+
+func TestItSkipAndOmitEmpty(t *testing.T) {
+	type Embedded struct {
+		A string `verify:"required"`
+	}
+	type A struct {
+		Embedded `verify:"-"`
+		A        string `verify:"-"`
+		B        string `verify:"omitempty,minSize=3"`
+		C        string `verify:"required,omitempty,minSize=2"`
+		D        []int  `verify:"dive,omitempty,min=1"`
+		E        string `verify:"minSize=2,omitempty"`
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"zero values skipped", A{C: "ab"}, false},
+		{"set value fails", A{B: "ab", C: "ab"}, true},
+		{"required verified", A{}, true},
+		{"rules before omitempty skipped", A{C: "ab", E: ""}, false},
+		{"rules before omitempty verified", A{C: "ab", E: "a"}, true},
+		{"rules after omitempty verified", A{C: "a"}, true},
+		{"zero elements skipped", A{C: "ab", D: []int{0, 1}}, false},
+		{"set element fails", A{C: "ab", D: []int{-1}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItMsg(t *testing.T) {
+	type A struct {
+		A string `verify:"len=8,regex=^[A-Z0-9]+$,msg='must be a valid promo code, such as SPRING24'"`
+		B []int  `verify:"minSize=1,msg=needs an item,dive,min=1,msg=must be positive"`
+	}
+
+	err := verify.It(A{A: "spring", B: []int{1, 0}})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []string{
+		"A must be a valid promo code, such as SPRING24",
+		"A must be a valid promo code, such as SPRING24",
+		"B[1] must be positive",
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i].Error(); got != w {
+			t.Errorf("error %d: want %q, got %q", i, w, got)
+		}
+	}
+	if !errors.Is(ve.Errors[0], verify.ErrLen) {
+		t.Error("expected the sentinel error to be unchanged")
+	}
+
+	if err := verify.It(A{A: "SPRING24", B: nil}); err == nil || err.Error() !=
+		"verify found the following errors: [B needs an item]" {
+		t.Errorf("expected the collection message, got %v", err)
+	}
+	type B struct {
+		A string `verify:"msg="`
+	}
+	if err := verify.Check(B{}); err == nil {
+		t.Error("expected msg without a message to be invalid")
+	}
+}
+
+func TestItSecret(t *testing.T) {
+	type A struct {
+		A string   `verify:"secret,minSize=12"`
+		B []string `verify:"secret,minSize=2,dive,startswith=sk_"`
+		C string   `verify:"minSize=12"`
+	}
+	vd := verify.New(verify.WithValueInMessage(nil))
+	vd.RegisterMessage("startswith", "{field} must start with {param}, got {value}")
+
+	err := vd.It(A{A: "hunter2", B: []string{"pk_live"}, C: "abc"})
+	var ve *verify.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected a *verify.ValidationError, got %v", err)
+	}
+	want := []struct {
+		value interface{}
+		msg   string
+	}{
+		{verify.Redacted, "A has a length less than 12 (got [REDACTED])"},
+		{verify.Redacted, "B has a length less than 2 (got [REDACTED])"},
+		{verify.Redacted, "B[0] must start with sk_, got [REDACTED] (got [REDACTED])"},
+		{"abc", `C has a length less than 12 (got "abc")`},
+	}
+	if len(ve.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), err)
+	}
+	for i, w := range want {
+		if got := ve.Errors[i]; got.Value != w.value || got.Error() != w.msg {
+			t.Errorf("error %d: want %v %q, got %v %q", i, w.value, w.msg, got.Value, got.Error())
+		}
+	}
+	if strings.Contains(err.Error(), "hunter2") || strings.Contains(err.Error(), "pk_live") {
+		t.Errorf("expected secret values to be redacted, got %v", err)
+	}
 }
 
 func TestItMultipleValidationsFail(t *testing.T) {
 	type A struct {
-		A int `verify:"required,max=-1"`
+		A int `verify:"min=1,max=-1"`
 	}
 
 	err := verify.It(A{})
-	if err == nil || !strings.Contains(err.Error(), "zero value") || !strings.Contains(err.Error(), "greater than max") {
+	if err == nil || !strings.Contains(err.Error(), "less than min") ||
+		!strings.Contains(err.Error(), "greater than max") {
 		t.Error("expected err two contain two messages")
 	}
 
 }
 
+func TestItRequiredFirst(t *testing.T) {
+	type A struct {
+		A string `verify:"minSize=5,required"`
+		B int    `verify:"max=-1,required_if=A x"`
+		C string `verify:"minSize=5,warn:required"`
+		D string `verify:"minSize=5,msg=is needed,required"`
+	}
+
+	tests := []struct {
+		name  string
+		input A
+		want  []string
+	}{
+		{"missing values fail only required", A{}, []string{
+			"A is required but is set to zero value", "B has value greater than max -1", "C has a length less than 5",
+			"D is needed",
+		}},
+		{"conditionally missing values fail only required", A{A: "x", C: "abcde", D: "abcde"}, []string{
+			"A has a length less than 5", "B is required when A is x",
+		}},
+		{"set values fail other rules", A{A: "ab", B: 1, C: "abcde", D: "ab"}, []string{
+			"A has a length less than 5", "B has value greater than max -1", "D is needed",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify.It(tt.input)
+			var ve *verify.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("expected a *verify.ValidationError, got %v", err)
+			}
+			var got []string
+			for _, fe := range ve.Errors {
+				got = append(got, fe.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	res := verify.Evaluate(A{C: "abcde", D: "abcde"})
+	if rules := res.Fields[0].Rules; len(rules) != 2 || rules[0].Tag != "required" || rules[1].Outcome != verify.Skipped {
+		t.Errorf("expected required to be verified first and minSize to be skipped, got %+v", rules)
+	}
+}
+
+func TestItMultipleFieldsFail(t *testing.T) {
+	type A struct {
+		A int    `verify:"required"`
+		B string `verify:"minSize=2"`
+	}
+
+	err := verify.It(A{})
+	if err == nil || !strings.Contains(err.Error(), "A is required") || !strings.Contains(err.Error(), "B has a length") {
+		t.Errorf("expected err to describe both fields, got %v", err)
+	}
+}
+
+func TestItEmbedded(t *testing.T) {
+	type Audit struct {
+		CreatedBy string `verify:"required"`
+	}
+	type A struct {
+		Audit
+		B string `verify:"maxSize=3"`
+	}
+	type B struct {
+		*Audit
+	}
+	type audit struct {
+		By string `verify:"required"`
+	}
+	type C struct {
+		audit
+	}
+	type D struct {
+		*audit
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"embedded field fails", A{}, true},
+		{"outer field fails", A{Audit{"a"}, "abcd"}, true},
+		{"works", A{Audit{"a"}, "abc"}, false},
+		{"nil embedded pointer", B{}, false},
+		{"embedded pointer fails", B{&Audit{}}, true},
+		{"works embedded pointer", B{&Audit{"a"}}, false},
+		{"unexported embedded field fails", C{}, true},
+		{"unexported embedded field fails by pointer", &C{}, true},
+		{"works unexported embedded", C{audit{"a"}}, false},
+		{"nil unexported embedded pointer", D{}, false},
+		{"unexported embedded pointer fails", D{&audit{}}, true},
+		{"works unexported embedded pointer", D{&audit{"a"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+
+	type E struct {
+		audit
+		Name string `verify:"minSize"`
+	}
+	var ce *verify.ConfigError
+	if err := verify.Check(E{}); !errors.As(err, &ce) {
+		t.Errorf("expected Check to report the invalid tag, got %v", err)
+	}
+	type F struct {
+		audit
+	}
+	if err := verify.Check(F{}); err != nil {
+		t.Errorf("expected Check to accept the tags of an unexported embedded struct, got %v", err)
+	}
+	var ve *verify.ValidationError
+	if err := verify.It(F{}); !errors.As(err, &ve) || ve.Errors[0].Field != "By" {
+		t.Errorf("expected the promoted field to be reported by its name, got %v", err)
+	}
+}
+
+func TestItDive(t *testing.T) {
+	type Item struct {
+		SKU string `verify:"required"`
+	}
+	type A struct {
+		A bool `verify:"dive,required"`
+	}
+	type B struct {
+		A []int `verify:"minSize=1,dive,min=1,max=100"`
+	}
+	type C struct {
+		A []Item `verify:"dive"`
+	}
+	type D struct {
+		A []*Item `verify:"dive,required"`
+	}
+	type E struct {
+		A [][]string `verify:"dive,dive,minSize=2"`
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantErr bool
+	}{
+		{"field wrong type", A{}, true},
+		{"collection fails", B{}, true},
+		{"element fails", B{[]int{1, 101}}, true},
+		{"works", B{[]int{1, 100}}, false},
+		{"struct element fails", C{[]Item{{"a"}, {}}}, true},
+		{"works struct element", C{[]Item{{"a"}}}, false},
+		{"nil pointer element", D{[]*Item{nil}}, true},
+		{"pointer element fails", D{[]*Item{{}}}, true},
+		{"works pointer element", D{[]*Item{{"a"}}}, false},
+		{"nested element fails", E{[][]string{{"ab", "a"}}}, true},
+		{"works nested element", E{[][]string{{"ab", "abc"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.It(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestItContext(t *testing.T) {
+	type A struct {
+		A string `verify:"minSize=2"`
+		B []int  `verify:"dive,min=1"`
+	}
+
+	if err := verify.ItContext(context.Background(), A{"ab", []int{1}}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	var ve *verify.ValidationError
+	if err := verify.ItContext(context.Background(), A{"a", nil}); !errors.As(err, &ve) {
+		t.Errorf("expected a *verify.ValidationError, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := verify.ItContext(ctx, A{"a", []int{0}}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestItValue(t *testing.T) {
+	type A struct {
+		A string `verify:"minSize=2"`
+	}
+	type B struct {
+		A A
+	}
+
+	tests := []struct {
+		name    string
+		input   reflect.Value
+		wantErr bool
+	}{
+		{"invalid value", reflect.Value{}, true},
+		{"int value", reflect.ValueOf(1), true},
+		{"field fails", reflect.ValueOf(A{"a"}), true},
+		{"works", reflect.ValueOf(A{"ab"}), false},
+		{"works *struct", reflect.ValueOf(&A{"ab"}), false},
+		{"inner struct field fails", reflect.ValueOf(B{A{"a"}}).Field(0), true},
+		{"works inner struct field", reflect.ValueOf(B{A{"ab"}}).Field(0), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.ItValue(tt.input)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestVar(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		rules   string
+		wantErr bool
+	}{
+		{"invalid rules", true, "minSize=5", true},
+		{"string too long", "abcdef", "required,maxSize=5", true},
+		{"works string", "abc", "required,maxSize=5", false},
+		{"int too small", 2, "min=3", true},
+		{"works int", 3, "min=3", false},
+		{"nil required", nil, "required", true},
+		{"element fails", []int{1, 5}, "dive,max=4", true},
+		{"works elements", []int{1, 4}, "dive,max=4", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verify.Var(tt.input, tt.rules)
+			if (tt.wantErr && got == nil) || (!tt.wantErr && got != nil) {
+				t.Errorf("wantErr is %v, while got is %v", tt.wantErr, got)
+			}
+		})
+	}
+}
+
 type Aer interface {
 	A()
 }